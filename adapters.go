@@ -0,0 +1,234 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+)
+
+// traverseItems Traverse seq executing operation on each item viewed as interface{}
+func traverseItems[T any](seq generic.Sequence[T], operation func(interface{}) bool) bool {
+  return seq.Traverse(func(item T) bool {
+    return operation(item)
+  })
+}
+
+// itemsOf Return the items asserted to T
+func itemsOf[T any](items []interface{}) []T {
+  ret := make([]T, 0, len(items))
+  for _, item := range items {
+    ret = append(ret, item.(T))
+  }
+  return ret
+}
+
+// SliceSeq A Sequence view of a []T. The items are not copied
+type SliceSeq[T any] struct {
+  s *generic.SliceSeq[T]
+}
+
+// SliceOf Return a Sequence view of s, so that it can be consumed by Map, Filter, Zip, etc. without copying it
+func SliceOf[T any](s []T) *SliceSeq[T] {
+  return &SliceSeq[T]{s: generic.SliceOf(s)}
+}
+
+// Slice Return the underlying slice. No copy is done
+func (seq *SliceSeq[T]) Slice() []T {
+  return seq.s.Slice()
+}
+
+func (seq *SliceSeq[T]) Create(items ...interface{}) interface{} {
+  return SliceOf(itemsOf[T](items))
+}
+
+// Traverse the slice and execute operation on each item. It stops if operation returns false
+func (seq *SliceSeq[T]) Traverse(operation func(interface{}) bool) bool {
+  return traverseItems[T](seq.s, operation)
+}
+
+// Append one or more items of type T to the underlying slice
+func (seq *SliceSeq[T]) Append(item interface{}, items ...interface{}) interface{} {
+  seq.s.Append(item.(T), itemsOf[T](items)...)
+  return seq
+}
+
+// Size Return the length of the slice
+func (seq *SliceSeq[T]) Size() int {
+  return seq.s.Size()
+}
+
+// Swap in O(1) two sequences
+func (seq *SliceSeq[T]) Swap(other interface{}) interface{} {
+  otherSeq := other.(*SliceSeq[T])
+  seq.s, otherSeq.s = otherSeq.s, seq.s
+  return seq
+}
+
+// IsEmpty Return true if the slice is empty
+func (seq *SliceSeq[T]) IsEmpty() bool {
+  return seq.s.IsEmpty()
+}
+
+// Nth Return the n-th item of the slice
+func (seq *SliceSeq[T]) Nth(i int) interface{} {
+  return seq.s.Nth(i)
+}
+
+// CreateIterator Return an iterator to the slice compliant with the interface Sequence
+func (seq *SliceSeq[T]) CreateIterator() interface{} {
+  return &typedIterator[T]{it: seq.s.CreateIterator()}
+}
+
+// MapSeq A Sequence view of a map[K]V whose items are the Pair (key, value). The order of the pairs is
+// unspecified. The map is not copied
+type MapSeq[K comparable, V any] struct {
+  m *generic.MapSeq[K, V]
+}
+
+// MapOf Return a Sequence view of m
+func MapOf[K comparable, V any](m map[K]V) *MapSeq[K, V] {
+  return &MapSeq[K, V]{m: generic.MapOf(m)}
+}
+
+// Map Return the underlying map. No copy is done
+func (seq *MapSeq[K, V]) Map() map[K]V {
+  return seq.m.Map()
+}
+
+func (seq *MapSeq[K, V]) Create(items ...interface{}) interface{} {
+  ret := MapOf(make(map[K]V, len(items)))
+  for _, item := range items {
+    ret.Append(item)
+  }
+  return ret
+}
+
+// Traverse the map and execute operation on each Pair. It stops if operation returns false
+func (seq *MapSeq[K, V]) Traverse(operation func(interface{}) bool) bool {
+  return seq.m.Traverse(func(p generic.Pair[K, V]) bool {
+    return operation(pairOf(p))
+  })
+}
+
+// Append one or more Pair (key, value) to the map. The value of an existing key is replaced
+func (seq *MapSeq[K, V]) Append(item interface{}, items ...interface{}) interface{} {
+  for _, i := range append([]interface{}{item}, items...) {
+    p := i.(Pair)
+    seq.m.Put(p.Item1.(K), p.Item2.(V))
+  }
+  return seq
+}
+
+// Size Return the number of keys of the map
+func (seq *MapSeq[K, V]) Size() int {
+  return seq.m.Size()
+}
+
+// Swap in O(1) two sequences
+func (seq *MapSeq[K, V]) Swap(other interface{}) interface{} {
+  otherSeq := other.(*MapSeq[K, V])
+  seq.m, otherSeq.m = otherSeq.m, seq.m
+  return seq
+}
+
+// IsEmpty Return true if the map is empty
+func (seq *MapSeq[K, V]) IsEmpty() bool {
+  return seq.m.IsEmpty()
+}
+
+// CreateIterator Return an iterator to the map compliant with the interface Sequence
+func (seq *MapSeq[K, V]) CreateIterator() interface{} {
+  pairs := generic.MapStream(generic.NewStream[generic.Pair[K, V]](seq.m), pairOf[K, V])
+  return &typedIterator[Pair]{it: pairs.CreateIterator()}
+}
+
+// pairOf Return p as a Pair of interfaces
+func pairOf[K, V any](p generic.Pair[K, V]) Pair {
+  return Pair{Item1: p.Item1, Item2: p.Item2}
+}
+
+// ChanSeq A single pass Sequence view of a channel. It is its own SequentialIterator: every item is received
+// when it is requested and is not kept afterwards. Traverse, Size and IsEmpty start at the current item and a
+// complete traversal blocks until the channel is closed. A ChanSeq is not safe for concurrent use
+type ChanSeq[T any] struct {
+  ch *generic.ChanSeq[T]
+}
+
+// ChanOf Return a Sequence view of ch
+func ChanOf[T any](ch <-chan T) *ChanSeq[T] {
+  return &ChanSeq[T]{ch: generic.ChanOf(ch)}
+}
+
+func (seq *ChanSeq[T]) Create(items ...interface{}) interface{} {
+  ch := make(chan T, len(items))
+  for _, item := range itemsOf[T](items) {
+    ch <- item
+  }
+  close(ch)
+  return ChanOf(ch)
+}
+
+// Traverse the remaining items and execute operation on each one. It stops if operation returns false
+func (seq *ChanSeq[T]) Traverse(operation func(interface{}) bool) bool {
+  return traverseItems[T](seq.ch, operation)
+}
+
+// Append one or more items of type T to be yielded after the channel is closed
+func (seq *ChanSeq[T]) Append(item interface{}, items ...interface{}) interface{} {
+  seq.ch.Append(item.(T), itemsOf[T](items)...)
+  return seq
+}
+
+// Size Receive until the channel is closed and return the number of remaining items
+func (seq *ChanSeq[T]) Size() int {
+  return seq.ch.Size()
+}
+
+// Swap in O(1) two sequences
+func (seq *ChanSeq[T]) Swap(other interface{}) interface{} {
+  otherSeq := other.(*ChanSeq[T])
+  seq.ch, otherSeq.ch = otherSeq.ch, seq.ch
+  return seq
+}
+
+// IsEmpty Return true if there are no remaining items. It blocks until an item is received or the channel is
+// closed
+func (seq *ChanSeq[T]) IsEmpty() bool {
+  return seq.ch.IsEmpty()
+}
+
+// CreateIterator Return the sequence itself, which is its own iterator
+func (seq *ChanSeq[T]) CreateIterator() interface{} {
+  return seq
+}
+
+// ResetFirst Do nothing; a channel cannot be received again
+func (seq *ChanSeq[T]) ResetFirst() interface{} {
+  return seq
+}
+
+// HasCurr Return true if the iterator is on an item. It blocks until the item is received or the channel is
+// closed
+func (seq *ChanSeq[T]) HasCurr() bool {
+  return seq.ch.HasCurr()
+}
+
+// GetCurr Return the current item
+func (seq *ChanSeq[T]) GetCurr() interface{} {
+  return seq.ch.GetCurr()
+}
+
+// Next Advance to the next item
+func (seq *ChanSeq[T]) Next() interface{} {
+  seq.ch.Next()
+  return seq
+}
+
+// ToSlice Return a new slice with the items of seq
+func ToSlice(seq Sequence) []interface{} {
+  return generic.ToSlice(generic.Of[interface{}](seq))
+}
+
+// ToMap Return a new map from the Pair (key, value) of seq. The keys must be comparable. If a key is
+// repeated, the value of its last pair is kept
+func ToMap(seq Sequence) map[interface{}]interface{} {
+  return generic.ToMap(generic.Of[Pair](seq))
+}
@@ -0,0 +1,97 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "sort"
+  "testing"
+)
+
+func TestSliceOf(t *testing.T) {
+
+  s := []int{3, 1, 4, 1, 5}
+  seq := SliceOf(s)
+  assert.Equal(t, seq.Size(), 5)
+  assert.Equal(t, Map(seq, func(i interface{}) interface{} {
+    return 2 * i.(int)
+  }).ToSlice(), []interface{}{6, 2, 8, 2, 10})
+  assert.Equal(t, Foldr(seq, 0, func(item, acu interface{}) interface{} {
+    return item.(int) - acu.(int)
+  }), 3-(1-(4-(1-(5-0)))))
+
+  // the view does not copy the slice
+  s[0] = 9
+  assert.Equal(t, Nth(seq, 0), 9)
+
+  seq.Append(2, 6)
+  assert.Equal(t, seq.Slice(), []int{9, 1, 4, 1, 5, 2, 6})
+  assert.Equal(t, seq.Create(7, 8).(*SliceSeq[int]).Slice(), []int{7, 8})
+  assert.Panics(t, func() { seq.Append("x") })
+
+  it := seq.CreateIterator().(SequentialIterator)
+  it.Next()
+  assert.Equal(t, it.GetCurr(), 1)
+}
+
+func TestMapOf(t *testing.T) {
+
+  m := map[string]int{"a": 1, "b": 2, "c": 3}
+  seq := MapOf(m)
+  assert.Equal(t, seq.Size(), 3)
+
+  keys := ToSlice(Map(seq, func(p interface{}) interface{} {
+    return p.(Pair).Item1
+  }))
+  sort.Slice(keys, func(i, j int) bool { return keys[i].(string) < keys[j].(string) })
+  assert.Equal(t, keys, []interface{}{"a", "b", "c"})
+
+  big := Filter(seq, func(p interface{}) bool { return p.(Pair).Item2.(int) > 1 })
+  assert.Equal(t, ToMap(big), map[interface{}]interface{}{"b": 2, "c": 3})
+
+  seq.Append(Pair{Item1: "d", Item2: 4})
+  assert.Equal(t, m["d"], 4)
+
+  n := 0
+  for it := seq.CreateIterator().(SequentialIterator); it.HasCurr(); it.Next() {
+    p := it.GetCurr().(Pair)
+    assert.Equal(t, m[p.Item1.(string)], p.Item2)
+    n++
+  }
+  assert.Equal(t, n, 4)
+}
+
+func TestChanOf(t *testing.T) {
+
+  ch := make(chan int)
+  go func() {
+    for i := 0; i < N; i++ {
+      ch <- i
+    }
+    close(ch)
+  }()
+
+  seq := ChanOf(ch)
+  assert.False(t, seq.IsEmpty())
+  assert.Equal(t, seq.GetCurr(), 0)
+
+  // the items are consumed, so the folding starts after the taken ones
+  assert.Equal(t, Take(seq, 3).ToSlice(), []interface{}{0, 1, 2})
+  assert.Equal(t, Foldl(seq, 0, func(acu, i interface{}) interface{} {
+    return acu.(int) + i.(int)
+  }), N*(N-1)/2-3)
+  assert.Equal(t, seq.Size(), 0)
+  assert.True(t, seq.IsEmpty())
+
+  empty := make(chan string)
+  close(empty)
+  assert.True(t, ChanOf(empty).IsEmpty())
+}
+
+func TestToSliceToMap(t *testing.T) {
+
+  assert.Equal(t, ToSlice(Seq.New(1, "a")), []interface{}{1, "a"})
+  assert.Equal(t, ToSlice(NewTuple()), []interface{}{})
+
+  pairs := Zip(Seq.New("a", "b", "a"), Seq.New(1, 2, 3))
+  assert.Equal(t, ToMap(pairs), map[interface{}]interface{}{"a": 3, "b": 2})
+}
@@ -0,0 +1,48 @@
+package FunctionalLib
+
+import (
+  "context"
+  "github.com/lrleon/FunctionalLib/generic"
+)
+
+// The channel stages run in their own goroutines and return channels buffered with buffer items. An output
+// channel is closed when its inputs are closed and all their items have been delivered, or when ctx is done
+
+// MapChan Return a channel with the items received from in transformed with transformation
+func MapChan(ctx context.Context, in <-chan interface{}, buffer int,
+  transformation func(interface{}) interface{}) <-chan interface{} {
+
+  return generic.MapChan(ctx, in, buffer, transformation)
+}
+
+// FilterChan Return a channel with the items received from in satisfying predicate
+func FilterChan(ctx context.Context, in <-chan interface{}, buffer int,
+  predicate func(interface{}) bool) <-chan interface{} {
+
+  return generic.FilterChan(ctx, in, buffer, predicate)
+}
+
+// FanOut Distribute the items received from in among n channels. Every item is delivered to only one of them
+func FanOut(ctx context.Context, in <-chan interface{}, n, buffer int) []<-chan interface{} {
+  return generic.FanOut(ctx, in, n, buffer)
+}
+
+// FanIn Return a channel with the items received from all the channels ins
+func FanIn(ctx context.Context, buffer int, ins ...<-chan interface{}) <-chan interface{} {
+  return generic.FanIn(ctx, buffer, ins...)
+}
+
+// Batch Return a channel with tuples of size consecutive items received from in. When in is closed, the
+// remaining items are sent as a shorter tuple
+func Batch(ctx context.Context, in <-chan interface{}, size, buffer int) <-chan *Tuple {
+  return generic.MapChan(ctx, generic.Batch(ctx, in, size, 0), buffer,
+    func(t *generic.Tuple[interface{}]) *Tuple {
+      items := t.ToSlice()
+      return &Tuple{l: &items}
+    })
+}
+
+// Tee Return n channels, each one receiving every item received from in
+func Tee(ctx context.Context, in <-chan interface{}, n, buffer int) []<-chan interface{} {
+  return generic.Tee(ctx, in, n, buffer)
+}
@@ -0,0 +1,51 @@
+package FunctionalLib
+
+import (
+  "context"
+  "github.com/stretchr/testify/assert"
+  "testing"
+  "time"
+)
+
+func produce(items ...interface{}) <-chan interface{} {
+  ch := make(chan interface{}, len(items))
+  for _, item := range items {
+    ch <- item
+  }
+  close(ch)
+  return ch
+}
+
+func TestChannelPipeline(t *testing.T) {
+
+  ctx := context.Background()
+  words := produce("go", "", "is", "", "fun")
+  nonEmpty := FilterChan(ctx, words, 1, func(w interface{}) bool { return w != "" })
+  lengths := MapChan(ctx, nonEmpty, 1, func(w interface{}) interface{} { return len(w.(string)) })
+  assert.Equal(t, ToSlice(ChanOf(lengths)), []interface{}{2, 2, 3})
+
+  batches := ToSlice(ChanOf(Batch(ctx, produce(1, 2, 3), 2, 0)))
+  assert.Equal(t, len(batches), 2)
+  assert.Equal(t, *batches[0].(*Tuple).l, []interface{}{1, 2})
+  assert.Equal(t, *batches[1].(*Tuple).l, []interface{}{3})
+}
+
+func TestFanOutTeeFanIn(t *testing.T) {
+
+  ctx := context.Background()
+  copies := Tee(ctx, produce(1, 2, 3), 2, 3)
+  merged := FanIn(ctx, 0, FanOut(ctx, copies[0], 2, 0)...)
+  assert.Equal(t, Sorted(ChanOf(merged), cmpInt).ToSlice(), []interface{}{1, 2, 3})
+  assert.Equal(t, ToSlice(ChanOf(copies[1])), []interface{}{1, 2, 3})
+}
+
+func TestChannelTimeout(t *testing.T) {
+
+  ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+  defer cancel()
+
+  out := MapChan(ctx, make(chan interface{}), 0, func(i interface{}) interface{} { return i })
+  _, ok := <-out
+  assert.False(t, ok)
+  assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+}
@@ -0,0 +1,55 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+)
+
+// NextPermutation Rearrange in place the tuple into the lexicographically next greater permutation according
+// to less. If the tuple is the greatest permutation, then it is rearranged as the smallest one (sorted) and
+// false is returned
+func (tuple *Tuple) NextPermutation(less func(i1, i2 interface{}) bool) bool {
+  return tuple.view().NextPermutation(less)
+}
+
+// PrevPermutation Rearrange in place the tuple into the lexicographically previous permutation according to
+// less. If the tuple is the smallest permutation, then it is rearranged as the greatest one and false is
+// returned
+func (tuple *Tuple) PrevPermutation(less func(i1, i2 interface{}) bool) bool {
+  return tuple.view().PrevPermutation(less)
+}
+
+// Permutations Return a lazy stream of *Tuple with the n! permutations of the positions of the items of seq.
+// Equal items generate repeated permutations
+func Permutations(seq Sequence) *Stream {
+  return tupleStream(generic.Permutations(generic.Of[interface{}](seq)))
+}
+
+// Combinations Return a lazy stream of *Tuple with the k-combinations of the items of seq in lexicographic
+// order of their positions
+func Combinations(seq Sequence, k int) *Stream {
+  return tupleStream(generic.Combinations(generic.Of[interface{}](seq), k))
+}
+
+// CombinationsWithRepetition Return a lazy stream of *Tuple with the k-combinations with repetition of the
+// items of seq
+func CombinationsWithRepetition(seq Sequence, k int) *Stream {
+  return tupleStream(generic.CombinationsWithRepetition(generic.Of[interface{}](seq), k))
+}
+
+// PowerSet Return a lazy stream of *Tuple with the 2^n subsets of the items of seq, by increasing size
+func PowerSet(seq Sequence) *Stream {
+  return tupleStream(generic.PowerSet(generic.Of[interface{}](seq)))
+}
+
+// CartesianProduct Return a lazy stream of *Tuple with the cartesian product of seqs in lexicographic order,
+// the last sequence varying fastest. The Size of the stream is computed with ProductSize, without generating
+// the tuples
+func CartesianProduct(seqs ...Sequence) *Stream {
+  return tupleStream(generic.CartesianProduct(dynamicSeqs(seqs)...))
+}
+
+// ProductSize Return the number of tuples of the cartesian product of seqs, or math.MaxInt if it overflows
+// int. The sizes are taken with Size, so single pass sequences are consumed
+func ProductSize(seqs ...Sequence) int {
+  return generic.ProductSize(dynamicSeqs(seqs)...)
+}
@@ -0,0 +1,106 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func tuplesToSlices(l *Seq.Slist) [][]interface{} {
+  ret := make([][]interface{}, 0)
+  ForEach(l, func(t interface{}) {
+    ret = append(ret, *t.(*Tuple).l)
+  })
+  return ret
+}
+
+func TestTuple_NextPermutation(t *testing.T) {
+
+  tuple := NewTuple(1, 2, 3)
+  expected := [][]interface{}{{1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}
+  for _, e := range expected {
+    assert.True(t, tuple.NextPermutation(cmpInt))
+    assert.Equal(t, *tuple.l, e)
+  }
+  assert.False(t, tuple.NextPermutation(cmpInt))
+  assert.Equal(t, *tuple.l, []interface{}{1, 2, 3})
+
+  // repeated items generate only the distinct permutations
+  n := 1
+  for multiset := NewTuple(1, 1, 2, 2); multiset.NextPermutation(cmpInt); n++ {
+  }
+  assert.Equal(t, n, 6)
+
+  assert.False(t, NewTuple(1).NextPermutation(cmpInt))
+}
+
+func TestTuple_PrevPermutation(t *testing.T) {
+
+  tuple := NewTuple(3, 1, 2)
+  assert.True(t, tuple.PrevPermutation(cmpInt))
+  assert.Equal(t, *tuple.l, []interface{}{2, 3, 1})
+
+  tuple = NewTuple(1, 2, 3)
+  assert.False(t, tuple.PrevPermutation(cmpInt))
+  assert.Equal(t, *tuple.l, []interface{}{3, 2, 1})
+  assert.True(t, tuple.PrevPermutation(cmpInt))
+  assert.Equal(t, *tuple.l, []interface{}{3, 1, 2})
+
+  var zero Tuple
+  assert.False(t, zero.NextPermutation(cmpInt))
+  assert.False(t, zero.PrevPermutation(cmpInt))
+}
+
+func TestPermutations(t *testing.T) {
+
+  perms := Permutations(NewTuple("a", "b", "c"))
+  assert.Equal(t, perms.Size(), 6)
+  assert.Equal(t, tuplesToSlices(perms.Take(2).ToSlist()), [][]interface{}{{"a", "b", "c"}, {"a", "c", "b"}})
+
+  starting := perms.Filter(func(p interface{}) bool {
+    return p.(*Tuple).Nth(0) == "c"
+  }).ToSlist()
+  assert.Equal(t, tuplesToSlices(starting), [][]interface{}{{"c", "a", "b"}, {"c", "b", "a"}})
+
+  assert.Equal(t, Permutations(NewTuple()).Size(), 1)
+}
+
+func TestCombinations(t *testing.T) {
+
+  assert.Equal(t, tuplesToSlices(Combinations(Seq.New(1, 2, 3, 4), 2).ToSlist()),
+    [][]interface{}{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}})
+  assert.Equal(t, Combinations(createSet(), 3).Size(), N*(N-1)*(N-2)/6)
+  assert.Equal(t, Combinations(Seq.New(1, 2), 0).Size(), 1)
+  assert.True(t, Combinations(Seq.New(1, 2), 3).IsEmpty())
+
+  assert.Equal(t, tuplesToSlices(CombinationsWithRepetition(Seq.New(1, 2, 3), 2).ToSlist()),
+    [][]interface{}{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}})
+  assert.True(t, CombinationsWithRepetition(Seq.New(), 2).IsEmpty())
+}
+
+func TestPowerSet(t *testing.T) {
+
+  assert.Equal(t, tuplesToSlices(PowerSet(Seq.New("x", "y", "z")).ToSlist()),
+    [][]interface{}{{}, {"x"}, {"y"}, {"z"}, {"x", "y"}, {"x", "z"}, {"y", "z"}, {"x", "y", "z"}})
+  assert.Equal(t, PowerSet(Take(createSet(), 10)).Size(), 1024)
+  assert.Equal(t, PowerSet(Seq.New()).Size(), 1)
+
+  it := PowerSet(Seq.New(1, 2)).CreateIterator().(SequentialIterator)
+  it.Next()
+  assert.Equal(t, *it.GetCurr().(*Tuple).l, []interface{}{1})
+}
+
+func TestCartesianProduct(t *testing.T) {
+
+  sizes, colors := Seq.New("S", "M"), NewTuple("red", "green", "blue")
+  product := CartesianProduct(sizes, colors, Seq.New(true))
+  assert.Equal(t, ProductSize(sizes, colors, Seq.New(true)), 6)
+  assert.Equal(t, product.Size(), 6)
+  assert.Equal(t, tuplesToSlices(product.Take(4).ToSlist()), [][]interface{}{
+    {"S", "red", true}, {"S", "green", true}, {"S", "blue", true}, {"M", "red", true}})
+
+  assert.True(t, CartesianProduct(sizes, Seq.New()).IsEmpty())
+  assert.Equal(t, ProductSize(sizes, Seq.New()), 0)
+  assert.Equal(t, CartesianProduct().Size(), 1)
+  assert.Equal(t, CartesianProduct(createSet(), createSet()).Size(), N*N)
+}
@@ -0,0 +1,43 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+)
+
+// TupleKey A comparable value built from the items of a tuple, so that tuples can be used as keys of maps.
+// Returned by Key
+type TupleKey = generic.TupleKey
+
+// Equal Return true if both tuples have the same size and their items in the same position are deeply equal
+func (tuple *Tuple) Equal(other *Tuple) bool {
+  return tuple.view().Equal(other.view())
+}
+
+// EqualFunc Return true if both tuples have the same size and eq is true for every pair of items in the same
+// position
+func (tuple *Tuple) EqualFunc(other *Tuple, eq func(i1, i2 interface{}) bool) bool {
+  return tuple.view().EqualFunc(other.view(), eq)
+}
+
+// Compare Compare lexicographically the tuples according to less, which orders their items. Return -1 if
+// tuple goes before other, 1 if it goes after and 0 if they are equivalent
+func (tuple *Tuple) Compare(other *Tuple, less func(i1, i2 interface{}) bool) int {
+  return tuple.view().Compare(other.view(), less)
+}
+
+// Less Return true if tuple goes lexicographically before other according to less
+func (tuple *Tuple) Less(other *Tuple, less func(i1, i2 interface{}) bool) bool {
+  return tuple.view().Less(other.view(), less)
+}
+
+// Hash Return a hash of the items of the tuple, stable between executions. Equal tuples have the same hash,
+// unless they hold cyclic values. See generic.Tuple.Hash
+func (tuple *Tuple) Hash() uint64 {
+  return tuple.view().Hash()
+}
+
+// Key Return a comparable value with the items of the tuple. Two tuples have equal keys if they have the same
+// size and equal items (with ==) in the same positions. It panics if some item is not comparable
+func (tuple *Tuple) Key() TupleKey {
+  return tuple.view().Key()
+}
@@ -0,0 +1,51 @@
+package FunctionalLib
+
+import (
+  "github.com/stretchr/testify/assert"
+  "strings"
+  "testing"
+)
+
+func TestTuple_Equal(t *testing.T) {
+
+  assert.True(t, NewTuple(1, "a", []int{1, 2}).Equal(NewTuple(1, "a", []int{1, 2})))
+  assert.False(t, NewTuple(1, "a").Equal(NewTuple(1, "b")))
+  assert.False(t, NewTuple(1).Equal(NewTuple(1, 1)))
+  assert.True(t, NewTuple().Equal(BuildTuple(0)))
+
+  sameCase := func(i1, i2 interface{}) bool {
+    return strings.EqualFold(i1.(string), i2.(string))
+  }
+  assert.True(t, NewTuple("Go", "FUN").EqualFunc(NewTuple("go", "fun"), sameCase))
+  assert.False(t, NewTuple("Go").EqualFunc(NewTuple("c"), sameCase))
+}
+
+func TestTuple_Compare(t *testing.T) {
+
+  assert.Equal(t, NewTuple(1, 2, 3).Compare(NewTuple(1, 2, 4), cmpInt), -1)
+  assert.Equal(t, NewTuple(1, 3).Compare(NewTuple(1, 2, 4), cmpInt), 1)
+  assert.Equal(t, NewTuple(1, 2).Compare(NewTuple(1, 2), cmpInt), 0)
+  assert.Equal(t, NewTuple(1, 2).Compare(NewTuple(1, 2, 0), cmpInt), -1)
+  assert.True(t, NewTuple().Less(NewTuple(0), cmpInt))
+  assert.False(t, NewTuple(2).Less(NewTuple(1, 5), cmpInt))
+}
+
+func TestTuple_HashKey(t *testing.T) {
+
+  t1, t2 := NewTuple(1, "a", 2.5, []string{"x"}), NewTuple(1, "a", 2.5, []string{"x"})
+  assert.Equal(t, t1.Hash(), t2.Hash())
+  assert.NotEqual(t, t1.Hash(), NewTuple(1, "a", 2.5, []string{"y"}).Hash())
+  assert.NotEqual(t, NewTuple(1, 2).Hash(), NewTuple(2, 1).Hash())
+  assert.NotEqual(t, NewTuple(1).Hash(), NewTuple("1").Hash())
+
+  counts := make(map[TupleKey]int)
+  for _, tuple := range []*Tuple{NewTuple(1, "a"), NewTuple(1, "b"), NewTuple(1, "a"), NewTuple(nil)} {
+    counts[tuple.Key()]++
+  }
+  assert.Equal(t, counts[NewTuple(1, "a").Key()], 2)
+  assert.Equal(t, counts[NewTuple(nil).Key()], 1)
+  assert.Equal(t, len(counts), 3)
+  assert.True(t, NewTuple(1, 2).Key() != NewTuple(1, 2, 3).Key())
+
+  assert.Panics(t, func() { t1.Key() })
+}
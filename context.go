@@ -0,0 +1,53 @@
+package FunctionalLib
+
+import (
+  "context"
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// ForEachCtx Execute operation receiving every item of the sequence. The traversal stops as soon as ctx is
+// done, in which case ctx.Err() is returned
+func ForEachCtx(ctx context.Context, seq Sequence, operation func(interface{})) error {
+  return generic.ForEachCtx(ctx, generic.Of[interface{}](seq), operation)
+}
+
+// AllCtx Return true if all the elements of the sequence meets predicate. If ctx is done before the answer is
+// known, then it returns false and ctx.Err()
+func AllCtx(ctx context.Context, seq Sequence, predicate func(interface{}) bool) (bool, error) {
+  return generic.AllCtx(ctx, generic.Of[interface{}](seq), predicate)
+}
+
+// MapCtx Return a new seq with the items of sequence transformed with transformation. If ctx is done during
+// the traversal, then it returns the items transformed so far and ctx.Err()
+func MapCtx(ctx context.Context, seq Sequence, transformation func(interface{}) interface{}) (*Seq.Slist, error) {
+
+  ret, err := generic.MapCtx(ctx, generic.Of[interface{}](seq), transformation)
+  return ret.Slist(), err
+}
+
+// MapIfCtx Return a new seq with the items satisfying predicate transformed with transformation. If ctx is done
+// during the traversal, then it returns the partial list and ctx.Err()
+func MapIfCtx(ctx context.Context, seq Sequence,
+  transformation func(interface{}) interface{},
+  predicate func(interface{}) bool) (*Seq.Slist, error) {
+
+  ret, err := generic.MapIfCtx(ctx, generic.Of[interface{}](seq), transformation, predicate)
+  return ret.Slist(), err
+}
+
+// FilterCtx Return a list containing the items satisfying predicate. If ctx is done during the traversal, then
+// it returns the items selected so far and ctx.Err()
+func FilterCtx(ctx context.Context, seq Sequence, predicate func(interface{}) bool) (*Seq.Slist, error) {
+
+  ret, err := generic.FilterCtx(ctx, generic.Of[interface{}](seq), predicate)
+  return ret.Slist(), err
+}
+
+// FoldlCtx Return f(in, ..., f(i2, f(i1, initVal) ... )). If ctx is done during the traversal, then it returns
+// the accumulated value so far and ctx.Err()
+func FoldlCtx(ctx context.Context, seq Sequence, initVal interface{},
+  f func(acu, item interface{}) interface{}) (interface{}, error) {
+
+  return generic.FoldlCtx(ctx, generic.Of[interface{}](seq), initVal, f)
+}
@@ -0,0 +1,78 @@
+package FunctionalLib
+
+import (
+  "context"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestForEachCtx(t *testing.T) {
+
+  i := 0
+  assert.Nil(t, ForEachCtx(context.Background(), createSet(), func(item interface{}) {
+    i++
+  }))
+  assert.Equal(t, i, N)
+
+  ctx, cancel := context.WithCancel(context.Background())
+  i = 0
+  err := ForEachCtx(ctx, createSet(), func(item interface{}) {
+    i++
+    if item.(int) == 9 {
+      cancel()
+    }
+  })
+  assert.ErrorIs(t, err, context.Canceled)
+  assert.Equal(t, i, 10)
+}
+
+func TestMapCtx(t *testing.T) {
+
+  ctx, cancel := context.WithCancel(context.Background())
+  m, err := MapCtx(ctx, createSet(), func(i interface{}) interface{} {
+    if i.(int) == 4 {
+      cancel()
+    }
+    return 2 * i.(int)
+  })
+  assert.ErrorIs(t, err, context.Canceled)
+  assert.Equal(t, m.ToSlice(), []interface{}{0, 2, 4, 6, 8})
+
+  m, err = MapIfCtx(context.Background(), createSet(), func(i interface{}) interface{} {
+    return -i.(int)
+  }, func(i interface{}) bool {
+    return i.(int) < 3
+  })
+  assert.Nil(t, err)
+  assert.Equal(t, m.ToSlice(), []interface{}{0, -1, -2})
+}
+
+func TestFilterFoldlAllCtx(t *testing.T) {
+
+  ctx, cancel := context.WithCancel(context.Background())
+  cancel()
+
+  l, err := FilterCtx(ctx, createSet(), func(interface{}) bool { return true })
+  assert.ErrorIs(t, err, context.Canceled)
+  assert.True(t, l.IsEmpty())
+
+  sum, err := FoldlCtx(context.Background(), createSet(), 0, func(acu, item interface{}) interface{} {
+    return acu.(int) + item.(int)
+  })
+  assert.Nil(t, err)
+  assert.Equal(t, sum, N*(N-1)/2)
+
+  sum, err = FoldlCtx(ctx, createSet(), 0, func(acu, item interface{}) interface{} {
+    return acu.(int) + item.(int)
+  })
+  assert.ErrorIs(t, err, context.Canceled)
+  assert.Equal(t, sum, 0)
+
+  ok, err := AllCtx(context.Background(), createSet(), func(i interface{}) bool { return i.(int) < N })
+  assert.True(t, ok)
+  assert.Nil(t, err)
+
+  ok, err = AllCtx(ctx, createSet(), func(i interface{}) bool { return i.(int) < N })
+  assert.False(t, ok)
+  assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,57 @@
+package FunctionalLib
+
+import (
+  "fmt"
+  "github.com/lrleon/FunctionalLib/generic"
+)
+
+// Pair gets its encodings from generic.Pair: it is written as (a, b) by fmt and encoded as the plain struct
+// by encoding/json and gob
+
+// Format Implement fmt.Formatter. The tuple is written as (a, b, c), each item formatted with the verb and
+// flags received, so that %v and %+v apply to every item
+func (tuple *Tuple) Format(f fmt.State, verb rune) {
+  tuple.view().Format(f, verb)
+}
+
+// String Return the tuple as (a, b, c)
+func (tuple *Tuple) String() string {
+  return tuple.view().String()
+}
+
+// MarshalText Implement encoding.TextMarshaler. The text is the String of the tuple
+func (tuple *Tuple) MarshalText() ([]byte, error) {
+  return tuple.view().MarshalText()
+}
+
+// MarshalJSON Implement json.Marshaler. The tuple is encoded as a JSON array
+func (tuple *Tuple) MarshalJSON() ([]byte, error) {
+  return tuple.view().MarshalJSON()
+}
+
+// UnmarshalJSON Implement json.Unmarshaler. The items get the default types of encoding/json (float64,
+// string, []interface{}, map[string]interface{}, ...). Use generic.Tuple for decoding typed items
+func (tuple *Tuple) UnmarshalJSON(data []byte) error {
+  return tuple.decode(data, (*generic.Tuple[interface{}]).UnmarshalJSON)
+}
+
+// GobEncode Implement gob.GobEncoder. The types of the items must be registered with gob.Register, except the
+// basic ones
+func (tuple *Tuple) GobEncode() ([]byte, error) {
+  return tuple.view().GobEncode()
+}
+
+// GobDecode Implement gob.GobDecoder
+func (tuple *Tuple) GobDecode(data []byte) error {
+  return tuple.decode(data, (*generic.Tuple[interface{}]).GobDecode)
+}
+
+func (tuple *Tuple) decode(data []byte, decoder func(*generic.Tuple[interface{}], []byte) error) error {
+  decoded := generic.NewTuple[interface{}]()
+  if err := decoder(decoded, data); err != nil {
+    return err
+  }
+  items := decoded.ToSlice()
+  tuple.l = &items
+  return nil
+}
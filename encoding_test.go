@@ -0,0 +1,76 @@
+package FunctionalLib
+
+import (
+  "bytes"
+  "encoding/gob"
+  "encoding/json"
+  "fmt"
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestTuple_Format(t *testing.T) {
+
+  tuple := NewTuple(1, "a", 2.5)
+  assert.Equal(t, tuple.String(), "(1, a, 2.5)")
+  assert.Equal(t, fmt.Sprintf("%v", tuple), "(1, a, 2.5)")
+  assert.Equal(t, fmt.Sprintf("%q", NewTuple("x", "y")), `("x", "y")`)
+  assert.Equal(t, fmt.Sprintf("%.1f", NewTuple(1.25, 2.0)), "(1.2, 2.0)")
+  assert.Equal(t, fmt.Sprint(NewTuple()), "()")
+
+  p := Pair{Item1: "k", Item2: NewTuple(1, 2)}
+  assert.Equal(t, p.String(), "(k, (1, 2))")
+
+  type point struct{ X, Y int }
+  assert.Equal(t, fmt.Sprintf("%+v", NewTuple(point{1, 2})), "({X:1 Y:2})")
+  assert.Equal(t, fmt.Sprintf("%+v", Pair{Item1: point{3, 4}, Item2: nil}), "({X:3 Y:4}, <nil>)")
+
+  text, err := tuple.MarshalText()
+  assert.NoError(t, err)
+  assert.Equal(t, string(text), "(1, a, 2.5)")
+}
+
+func TestTuple_JSON(t *testing.T) {
+
+  zipped := TZip(Seq.New(1, 2), Seq.New("a", "b"))
+  data, err := json.Marshal(ToSlice(zipped))
+  assert.NoError(t, err)
+  assert.Equal(t, string(data), `[[1,"a"],[2,"b"]]`)
+
+  var decoded Tuple
+  assert.NoError(t, json.Unmarshal([]byte(`[1, "a", [true]]`), &decoded))
+  assert.Equal(t, *decoded.l, []interface{}{1.0, "a", []interface{}{true}})
+
+  data, err = json.Marshal(struct {
+    T *Tuple
+    P Pair
+  }{NewTuple(), Pair{Item1: 1, Item2: "x"}})
+  assert.NoError(t, err)
+  assert.Equal(t, string(data), `{"T":[],"P":{"Item1":1,"Item2":"x"}}`)
+
+  var p Pair
+  assert.NoError(t, json.Unmarshal([]byte(`{"Item1": "k", "Item2": 2}`), &p))
+  assert.Equal(t, p, Pair{Item1: "k", Item2: 2.0})
+  assert.NoError(t, json.Unmarshal([]byte(`["k", 3]`), &p))
+  assert.Equal(t, p, Pair{Item1: "k", Item2: 3.0})
+  assert.Error(t, json.Unmarshal([]byte(`[1, 2, 3]`), &p))
+  assert.Error(t, json.Unmarshal([]byte(`{`), &decoded))
+}
+
+func TestTuple_Gob(t *testing.T) {
+
+  var buf bytes.Buffer
+  assert.NoError(t, gob.NewEncoder(&buf).Encode(NewTuple(1, "two", 3.0)))
+
+  var decoded Tuple
+  assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+  assert.Equal(t, *decoded.l, []interface{}{1, "two", 3.0})
+
+  buf.Reset()
+  pairs := ToSlice(Zip(Seq.New(1, 2), Seq.New("a", "b")))
+  assert.NoError(t, gob.NewEncoder(&buf).Encode(pairs[1].(Pair)))
+  var p Pair
+  assert.NoError(t, gob.NewDecoder(&buf).Decode(&p))
+  assert.Equal(t, p, Pair{Item1: 2, Item2: "b"})
+}
@@ -0,0 +1,66 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// ForEachErr Execute operation receiving every item of the sequence. It stops at the first error returned by
+// operation and returns it
+func ForEachErr(seq Sequence, operation func(interface{}) error) error {
+  return generic.ForEachErr(generic.Of[interface{}](seq), operation)
+}
+
+// ForEachErrAll Execute operation receiving every item of the sequence and return the errors.Join of all the
+// errors returned by operation
+func ForEachErrAll(seq Sequence, operation func(interface{}) error) error {
+  return generic.ForEachErrAll(generic.Of[interface{}](seq), operation)
+}
+
+// MapErr Return a new seq with the items of sequence transformed with transformation. It stops at the first
+// error and returns the items transformed so far with the error
+func MapErr(seq Sequence, transformation func(interface{}) (interface{}, error)) (*Seq.Slist, error) {
+
+  ret, err := generic.MapErr(generic.Of[interface{}](seq), transformation)
+  return ret.Slist(), err
+}
+
+// MapErrAll Return a new seq with the items successfully transformed with transformation and the errors.Join
+// of all the errors. The failed items are not in the list
+func MapErrAll(seq Sequence, transformation func(interface{}) (interface{}, error)) (*Seq.Slist, error) {
+
+  ret, err := generic.MapErrAll(generic.Of[interface{}](seq), transformation)
+  return ret.Slist(), err
+}
+
+// FilterErr Return a list containing the items satisfying predicate. It stops at the first error and returns
+// the items selected so far with the error
+func FilterErr(seq Sequence, predicate func(interface{}) (bool, error)) (*Seq.Slist, error) {
+
+  ret, err := generic.FilterErr(generic.Of[interface{}](seq), predicate)
+  return ret.Slist(), err
+}
+
+// FilterErrAll Return a list containing the items satisfying predicate and the errors.Join of all the errors.
+// The items for which predicate fails are not in the list
+func FilterErrAll(seq Sequence, predicate func(interface{}) (bool, error)) (*Seq.Slist, error) {
+
+  ret, err := generic.FilterErrAll(generic.Of[interface{}](seq), predicate)
+  return ret.Slist(), err
+}
+
+// FoldlErr Return f(in, ..., f(i2, f(i1, initVal) ... )). It stops at the first error and returns the
+// accumulated value so far with the error
+func FoldlErr(seq Sequence, initVal interface{},
+  f func(acu, item interface{}) (interface{}, error)) (interface{}, error) {
+
+  return generic.FoldlErr(generic.Of[interface{}](seq), initVal, f)
+}
+
+// FoldlErrAll Fold seq as Foldl skipping the items for which f fails. Return the accumulated value and the
+// errors.Join of all the errors
+func FoldlErrAll(seq Sequence, initVal interface{},
+  f func(acu, item interface{}) (interface{}, error)) (interface{}, error) {
+
+  return generic.FoldlErrAll(generic.Of[interface{}](seq), initVal, f)
+}
@@ -0,0 +1,91 @@
+package FunctionalLib
+
+import (
+  "errors"
+  "fmt"
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "strconv"
+  "testing"
+)
+
+func parse(item interface{}) (interface{}, error) {
+  return strconv.Atoi(item.(string))
+}
+
+func TestMapErr(t *testing.T) {
+
+  l := Seq.New("1", "2", "x", "4", "y")
+
+  m, err := MapErr(l, parse)
+  assert.Error(t, err)
+  assert.Equal(t, m.ToSlice(), []interface{}{1, 2})
+
+  m, err = MapErrAll(l, parse)
+  assert.Equal(t, m.ToSlice(), []interface{}{1, 2, 4})
+  assert.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 2)
+
+  m, err = MapErr(Seq.New("7", "8"), parse)
+  assert.Nil(t, err)
+  assert.Equal(t, m.ToSlice(), []interface{}{7, 8})
+}
+
+func TestFilterErr(t *testing.T) {
+
+  errOdd := errors.New("odd")
+  pred := func(i interface{}) (bool, error) {
+    if i.(int)%2 == 1 {
+      return false, fmt.Errorf("%d: %w", i, errOdd)
+    }
+    return i.(int) < 5, nil
+  }
+
+  l, err := FilterErr(NewTuple(0, 2, 4, 5, 6, 7), pred)
+  assert.ErrorIs(t, err, errOdd)
+  assert.Equal(t, err.Error(), "5: odd")
+  assert.Equal(t, l.ToSlice(), []interface{}{0, 2, 4})
+
+  l, err = FilterErrAll(NewTuple(0, 2, 4, 5, 6, 7), pred)
+  assert.ErrorIs(t, err, errOdd)
+  assert.Equal(t, err.Error(), "5: odd\n7: odd")
+  assert.Equal(t, l.ToSlice(), []interface{}{0, 2, 4})
+}
+
+func TestFoldlForEachErr(t *testing.T) {
+
+  add := func(acu, item interface{}) (interface{}, error) {
+    i, err := parse(item)
+    if err != nil {
+      return nil, err
+    }
+    return acu.(int) + i.(int), nil
+  }
+
+  sum, err := FoldlErr(Seq.New("1", "2", "x", "4"), 0, add)
+  assert.Error(t, err)
+  assert.Equal(t, sum, 3)
+
+  sum, err = FoldlErrAll(Seq.New("1", "2", "x", "4"), 0, add)
+  assert.Error(t, err)
+  assert.Equal(t, sum, 7)
+
+  n := 0
+  err = ForEachErr(Seq.New("1", "x", "y"), func(item interface{}) error {
+    n++
+    _, err := parse(item)
+    return err
+  })
+  assert.Error(t, err)
+  assert.Equal(t, n, 2)
+
+  n = 0
+  err = ForEachErrAll(Seq.New("1", "x", "y"), func(item interface{}) error {
+    n++
+    _, err := parse(item)
+    return err
+  })
+  assert.Error(t, err)
+  assert.Equal(t, n, 3)
+
+  assert.Nil(t, ForEachErrAll(createSet(), func(interface{}) error { return nil }))
+}
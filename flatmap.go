@@ -0,0 +1,59 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// FlatMap Return a list with the concatenation of the sequences resulting of applying transformation to every
+// item of seq
+func FlatMap(seq Sequence, transformation func(interface{}) Sequence) *Seq.Slist {
+  return generic.FlatMap(generic.Of[interface{}](seq), func(item interface{}) generic.Sequence[interface{}] {
+    return generic.Of[interface{}](transformation(item))
+  }).Slist()
+}
+
+// Flatten Return a list with the concatenation of the items of seq that are sequences. The items that are not
+// sequences are kept as they are
+func Flatten(seq Sequence) *Seq.Slist {
+
+  ret := Seq.New()
+  ForEach(seq, func(item interface{}) {
+    if s, ok := item.(Sequence); ok {
+      s.Traverse(func(i interface{}) bool {
+        ret.Append(i)
+        return true
+      })
+    } else {
+      ret.Append(item)
+    }
+  })
+  return ret
+}
+
+// FlattenDeep Return a list with all the items that are not sequences found, at any depth of nesting, in seq.
+// For example, the nested tuple (1, (2, (3, 4)), 5) is flattened to 1, 2, 3, 4, 5
+func FlattenDeep(seq Sequence) *Seq.Slist {
+
+  ret := Seq.New()
+  var flatten func(s Sequence)
+  flatten = func(s Sequence) {
+    ForEach(s, func(item interface{}) {
+      if inner, ok := item.(Sequence); ok {
+        flatten(inner)
+      } else {
+        ret.Append(item)
+      }
+    })
+  }
+  flatten(seq)
+  return ret
+}
+
+// FlatMap Return a stream with the concatenation of the sequences resulting of applying transformation to every
+// item. Each sequence is created when the stream reaches it
+func (stream *Stream) FlatMap(transformation func(interface{}) Sequence) *Stream {
+  return &Stream{s: generic.FlatMapStream(stream.s, func(item interface{}) generic.Sequence[interface{}] {
+    return generic.Of[interface{}](transformation(item))
+  })}
+}
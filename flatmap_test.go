@@ -0,0 +1,52 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+type order struct {
+  id    int
+  items *Seq.Slist
+}
+
+func TestFlatMap(t *testing.T) {
+
+  orders := Seq.New(order{1, Seq.New("a", "b")}, order{2, Seq.New()}, order{3, Seq.New("c")})
+
+  items := FlatMap(orders, func(o interface{}) Sequence {
+    return o.(order).items
+  })
+  assert.Equal(t, items.ToSlice(), []interface{}{"a", "b", "c"})
+
+  repeated := FlatMap(NewTuple(1, 2, 3), func(i interface{}) Sequence {
+    tuple := NewTuple()
+    for k := 0; k < i.(int); k++ {
+      tuple.Append(i)
+    }
+    return tuple
+  })
+  assert.Equal(t, repeated.ToSlice(), []interface{}{1, 2, 2, 3, 3, 3})
+}
+
+func TestStream_FlatMap(t *testing.T) {
+
+  created := 0
+  l := NewStream(createSet()).FlatMap(func(i interface{}) Sequence {
+    created++
+    return NewTuple(i, -i.(int))
+  }).Take(5).ToSlist()
+
+  assert.Equal(t, l.ToSlice(), []interface{}{0, 0, 1, -1, 2})
+  assert.Equal(t, created, 3)
+}
+
+func TestFlatten(t *testing.T) {
+
+  nested := NewTuple(1, NewTuple(2, NewTuple(3, 4)), Seq.New(5, 6), 7)
+
+  assert.Equal(t, Flatten(nested).Size(), 6)
+  assert.Equal(t, Flatten(NewTuple(Seq.New(1, 2), NewTuple(), NewTuple(3))).ToSlice(), []interface{}{1, 2, 3})
+  assert.Equal(t, FlattenDeep(nested).ToSlice(), []interface{}{1, 2, 3, 4, 5, 6, 7})
+}
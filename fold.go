@@ -0,0 +1,30 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// Foldr Return f(i1, f(i2, ... f(in, initVal) ... )). Tuples are visited from right to left in place; other
+// sequences are first copied
+func Foldr(seq Sequence, initVal interface{}, f func(item, acu interface{}) interface{}) interface{} {
+  return generic.Foldr(generic.Of[interface{}](seq), initVal, f)
+}
+
+// Reduce Return f(... f(f(i1, i2), i3) ..., in), that is Foldl using the first item as initial value. It
+// returns None if the sequence is empty
+func Reduce(seq Sequence, f func(acu, item interface{}) interface{}) Option {
+  return generic.Reduce(generic.Of[interface{}](seq), f)
+}
+
+// Scanl Return the list of the successive accumulated values of Foldl: initVal, f(initVal, i1),
+// f(f(initVal, i1), i2), ... The last item is Foldl(seq, initVal, f)
+func Scanl(seq Sequence, initVal interface{}, f func(acu, item interface{}) interface{}) *Seq.Slist {
+  return generic.Scanl(generic.Of[interface{}](seq), initVal, f).Slist()
+}
+
+// Scanr Return the list of the successive accumulated values of Foldr: f(i1, f(i2, ...)), ..., f(in, initVal),
+// initVal. The first item is Foldr(seq, initVal, f)
+func Scanr(seq Sequence, initVal interface{}, f func(item, acu interface{}) interface{}) *Seq.Slist {
+  return generic.Scanr(generic.Of[interface{}](seq), initVal, f).Slist()
+}
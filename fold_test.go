@@ -0,0 +1,53 @@
+package FunctionalLib
+
+import (
+  "fmt"
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func showFold(item, acu interface{}) interface{} {
+  return fmt.Sprintf("(%v %v)", item, acu)
+}
+
+func TestFoldr(t *testing.T) {
+
+  assert.Equal(t, Foldr(NewTuple(1, 2, 3), "z", showFold), "(1 (2 (3 z)))")
+  assert.Equal(t, Foldr(Seq.New(1, 2, 3), "z", showFold), "(1 (2 (3 z)))")
+  assert.Equal(t, Foldr(NewTuple(), "z", showFold), "z")
+
+  // Foldr with cons rebuilds the list
+  l := Foldr(createSet(), Seq.New(), func(item, acu interface{}) interface{} {
+    return acu.(*Seq.Slist).Insert(item)
+  }).(*Seq.Slist)
+  assert.Equal(t, l.ToSlice(), Take(createSet(), N).ToSlice())
+}
+
+func TestReduce(t *testing.T) {
+
+  maxFn := func(acu, item interface{}) interface{} {
+    if item.(int) > acu.(int) {
+      return item
+    }
+    return acu
+  }
+
+  assert.Equal(t, Reduce(createSet(), maxFn).MustGet(), N-1)
+  assert.Equal(t, Reduce(NewTuple(7), maxFn).MustGet(), 7)
+  assert.True(t, Reduce(Seq.New(), maxFn).IsNone())
+}
+
+func TestScanlScanr(t *testing.T) {
+
+  add := func(acu, item interface{}) interface{} {
+    return acu.(int) + item.(int)
+  }
+
+  assert.Equal(t, Scanl(NewTuple(1, 2, 3, 4), 0, add).ToSlice(), []interface{}{0, 1, 3, 6, 10})
+  assert.Equal(t, Scanl(NewTuple(), 0, add).ToSlice(), []interface{}{0})
+  assert.Equal(t, Scanl(createSet(), 0, add).Last(), Foldl(createSet(), 0, add))
+
+  assert.Equal(t, Scanr(Seq.New(1, 2, 3, 4), 0, add).ToSlice(), []interface{}{10, 9, 7, 4, 0})
+  assert.Equal(t, Scanr(NewTuple(1, 2), "z", showFold).ToSlice(), []interface{}{"(1 (2 z))", "(2 z)", "z"})
+}
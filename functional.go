@@ -2,6 +2,7 @@ package FunctionalLib
 
 import (
   "fmt"
+  "github.com/lrleon/FunctionalLib/generic"
   Seq "github.com/lrleon/Slist"
 )
 
@@ -22,16 +23,62 @@ type Sequence interface {
   Create(items ...interface{}) interface{}
 }
 
-// Pair A pair of interfaces. Returned by Zip
-type Pair struct {
-  Item1, Item2 interface{}
+// typedIterator Adapt a generic iterator over items of type T to the interface SequentialIterator
+type typedIterator[T any] struct {
+  it generic.SequentialIterator[T]
+}
+
+func (it *typedIterator[T]) ResetFirst() interface{} {
+  it.it.ResetFirst()
+  return it
+}
+
+func (it *typedIterator[T]) HasCurr() bool {
+  return it.it.HasCurr()
+}
+
+func (it *typedIterator[T]) GetCurr() interface{} {
+  return it.it.GetCurr()
+}
+
+func (it *typedIterator[T]) Next() interface{} {
+  it.it.Next()
+  return it
+}
+
+// Stop Release the underlying iterator if it holds resources, such as the one of an IterSequence
+func (it *typedIterator[T]) Stop() {
+  if s, ok := it.it.(interface{ Stop() }); ok {
+    s.Stop()
+  }
+}
+
+// dynamicSeqs Return the generic views of seqs
+func dynamicSeqs(seqs []Sequence) []generic.Sequence[interface{}] {
+  ret := make([]generic.Sequence[interface{}], len(seqs))
+  for i, seq := range seqs {
+    ret[i] = generic.Of[interface{}](seq)
+  }
+  return ret
 }
 
+// Pair A pair of interfaces. Returned by Zip
+type Pair = generic.Pair[interface{}, interface{}]
+
 // Tuple Represent a tuple
 type Tuple struct {
   l *[]interface{}
 }
 
+// view Return the tuple as a generic tuple sharing its items. The items of a zero Tuple are allocated,
+// so that the insertions through the view are kept
+func (tuple *Tuple) view() *generic.Tuple[interface{}] {
+  if tuple.l == nil {
+    tuple.l = &[]interface{}{}
+  }
+  return generic.TupleOf(tuple.l)
+}
+
 // NewTuple Return a new tuple with the received elements
 func NewTuple(items ...interface{}) *Tuple {
 
@@ -246,33 +293,22 @@ func (tuple *Tuple) Clone() *Tuple {
 // ForEach Execute operation receiving every item of the sequence. Return seq
 func ForEach(seq Sequence, operation func(interface{})) interface{} {
 
-  seq.Traverse(func(i interface{}) bool {
-    operation(i)
-    return true
-  })
-
+  generic.ForEach(generic.Of[interface{}](seq), operation)
   return seq
 }
 
 // All Return true if all the elements of the sequence meets predicate
 func All(seq Sequence, predicate func(interface{}) bool) bool {
-  return seq.Traverse(predicate)
+  return generic.All(generic.Of[interface{}](seq), predicate)
 }
 
 func Exist(seq Sequence, predicate func(interface{}) bool) bool {
-  return !All(seq, func(i interface{}) bool {
-    return !predicate(i)
-  })
+  return generic.Exist(generic.Of[interface{}](seq), predicate)
 }
 
 // Map Return a new seq with the items of sequence transformed with transformation operation
 func Map(seq Sequence, transformation func(interface{}) interface{}) *Seq.Slist {
-
-  ret := Seq.New()
-  ForEach(seq, func(item interface{}) {
-    ret.Append(transformation(item))
-  })
-  return ret
+  return generic.Map(generic.Of[interface{}](seq), transformation).Slist()
 }
 
 // MapIf Return a new seq with the items of sequence transformed with transformation operation for the items
@@ -281,171 +317,106 @@ func MapIf(seq Sequence,
   transformation func(interface{}) interface{},
   predicate func(interface{}) bool) *Seq.Slist {
 
-  ret := Seq.New()
-  ForEach(seq, func(item interface{}) {
-    if predicate(item) {
-      ret.Append(transformation(item))
-    }
-  })
-  return ret
+  return generic.MapIf(generic.Of[interface{}](seq), transformation, predicate).Slist()
 }
 
 // Filter Return a list containing the items satisfying predicate
 func Filter(seq Sequence, predicate func(interface{}) bool) *Seq.Slist {
-
-  ret := Seq.New()
-  ForEach(seq, func(item interface{}) {
-    if predicate(item) {
-      ret.Append(item)
-    }
-  })
-  return ret
+  return generic.Filter(generic.Of[interface{}](seq), predicate).Slist()
 }
 
 // Search return the first item meeting predicate. If not found, then it return nil
 func Search(seq Sequence, predicate func(interface{}) bool) interface{} {
 
-  for it := seq.CreateIterator().(SequentialIterator); it.HasCurr(); it.Next() {
-    curr := it.GetCurr()
-    if predicate(curr) {
-      return curr
-    }
+  if item, ok := generic.Search(generic.Of[interface{}](seq), predicate); ok {
+    return item
   }
   return nil
 }
 
 // Zip two lists into one list of pair. The result is truncated to the shortest list
 func Zip(s1, s2 Sequence) *Seq.Slist {
-
-  ret := Seq.New()
-
-  it1, it2 := s1.CreateIterator().(SequentialIterator), s2.CreateIterator().(SequentialIterator)
-  for it1.HasCurr() && it2.HasCurr() {
-
-    ret.Append(Pair{
-      Item1: it1.GetCurr(),
-      Item2: it2.GetCurr(),
-    })
-
-    it1.Next()
-    it2.Next()
-  }
-
-  return ret
+  return generic.Zip(generic.Of[interface{}](s1), generic.Of[interface{}](s2)).Slist()
 }
 
 // Unzip a list of pairs into two separated lists
 func Unzip(seq *Seq.Slist) (*Seq.Slist, *Seq.Slist) {
 
-  l1 := Seq.New()
-  l2 := Seq.New()
-
-  for it := seq.CreateIterator().(*Seq.Iterator); it.HasCurr(); it.Next() {
-
-    curr := it.GetCurr().(Pair)
-    l1.Append(curr.Item1)
-    l2.Append(curr.Item2)
-  }
-
-  return l1, l2
+  l1, l2 := generic.Unzip(generic.Of[Pair](seq))
+  return l1.Slist(), l2.Slist()
 }
 
 // Split seq into two lists. First contains items satisfying predicate and the second the complement
 func Split(seq Sequence, predicate func(item interface{}) bool) (*Seq.Slist, *Seq.Slist) {
 
-  l1 := Seq.New()
-  l2 := Seq.New()
-
-  ForEach(seq, func(i interface{}) {
-    if predicate(i) {
-      l1.Append(i)
-    } else {
-      l2.Append(i)
-    }
-  })
-
-  return l1, l2
+  l1, l2 := generic.Split(generic.Of[interface{}](seq), predicate)
+  return l1.Slist(), l2.Slist()
 }
 
 // Find Return the first item in seq satisfying predicate. If not item is found, the it returns nil
 func Find(seq Sequence, predicate func(item interface{}) bool) interface{} {
 
-  for it := seq.CreateIterator().(SequentialIterator); it.HasCurr(); it.Next() {
-    item := it.GetCurr()
-    if predicate(item) {
-      return item
-    }
+  if item, ok := generic.Find(generic.Of[interface{}](seq), predicate); ok {
+    return item
   }
   return nil
 }
 
 // Take Return a sequence containing the first n items from the sequence
 func Take(seq Sequence, n int) *Seq.Slist {
-
-  ret := Seq.New()
-  for it := seq.CreateIterator().(SequentialIterator); it.HasCurr() && n > 0; it.Next() {
-    ret.Append(it.GetCurr())
-    n--
-  }
-
-  return ret
+  return generic.Take(generic.Of[interface{}](seq), n).Slist()
 }
 
 // Drop Return a sequence containing the items after the first n from the sequence
 func Drop(seq Sequence, n int) *Seq.Slist {
+  return generic.Drop(generic.Of[interface{}](seq), n).Slist()
+}
 
-  ret := Seq.New()
-  for i, it := 0, seq.CreateIterator().(SequentialIterator); it.HasCurr(); it.Next() {
-    if i < n {
-      i++
-      continue
-    }
-    ret.Append(it.GetCurr())
-  }
+// TakeWhile Return a sequence containing the longest prefix of seq whose items satisfy predicate
+func TakeWhile(seq Sequence, predicate func(item interface{}) bool) *Seq.Slist {
+  return generic.TakeWhile(generic.Of[interface{}](seq), predicate).Slist()
+}
 
-  return ret
+// DropWhile Return a sequence containing the items after the longest prefix of seq whose items satisfy predicate
+func DropWhile(seq Sequence, predicate func(item interface{}) bool) *Seq.Slist {
+  return generic.DropWhile(generic.Of[interface{}](seq), predicate).Slist()
+}
+
+// Span seq into two lists. First contains the longest prefix whose items satisfy predicate and the second the
+// remainder of seq
+func Span(seq Sequence, predicate func(item interface{}) bool) (*Seq.Slist, *Seq.Slist) {
+
+  l1, l2 := generic.Span(generic.Of[interface{}](seq), predicate)
+  return l1.Slist(), l2.Slist()
+}
+
+// SplitAt seq into two lists. First contains the first n items and the second the remainder
+func SplitAt(seq Sequence, n int) (*Seq.Slist, *Seq.Slist) {
+
+  l1, l2 := generic.SplitAt(generic.Of[interface{}](seq), n)
+  return l1.Slist(), l2.Slist()
 }
 
 // Foldl Return f(in, ..., f(i2, f(i1, initVal) ... ))
 func Foldl(seq Sequence, initVal interface{},
   f func(acu, item interface{}) interface{}) interface{} {
 
-  retVal := initVal
-  ForEach(seq, func(i interface{}) {
-    retVal = f(retVal, i)
-  })
-  return retVal
+  return generic.Foldl(generic.Of[interface{}](seq), initVal, f)
 }
 
 // Nth Return the n-th item in the sequence. Return nil if n is negative o greater than seq.Size()
 func Nth(seq Sequence, n int) interface{} {
 
-  if n < 0 || n >= seq.Size() {
-    return nil
+  if item, ok := generic.Nth(generic.Of[interface{}](seq), n); ok {
+    return item
   }
-
-  for it := seq.CreateIterator().(SequentialIterator); it.HasCurr(); it.Next() {
-    if n == 0 {
-      return it.GetCurr()
-    }
-    n--
-  }
-
-  return nil // it should not be reached!
+  return nil
 }
 
 // Position Return the position in the sequence of the first element satisfying predicate. If no element satisfies
 // predicate then it returns -1
 func Position(seq Sequence, predicate func(item interface{}) bool) int {
-
-  for pos, it := 0, seq.CreateIterator().(SequentialIterator); it.HasCurr(); it.Next() {
-    if predicate(it.GetCurr()) {
-      return pos
-    }
-    pos++
-  }
-
-  return -1
+  return generic.Position(generic.Of[interface{}](seq), predicate)
 }
 
 // TZip Zip all the lists into a list of tuples
@@ -194,6 +194,31 @@ func TestDrop(t *testing.T) {
   }))
 }
 
+func TestTakeWhileDropWhile(t *testing.T) {
+
+  l := Seq.New(2, 4, 6, 7, 8)
+  even := func(i interface{}) bool { return i.(int)%2 == 0 }
+  assert.Equal(t, TakeWhile(l, even).ToSlice(), []interface{}{2, 4, 6})
+  assert.Equal(t, DropWhile(l, even).ToSlice(), []interface{}{7, 8})
+
+  prefix, rest := Span(l, even)
+  assert.Equal(t, prefix.ToSlice(), []interface{}{2, 4, 6})
+  assert.Equal(t, rest.ToSlice(), []interface{}{7, 8})
+}
+
+func TestSplitAt(t *testing.T) {
+
+  l1, l2 := SplitAt(createSet(), 10)
+  assert.Equal(t, l1.Size(), 10)
+  assert.Equal(t, l2.Size(), N-10)
+  assert.True(t, All(l2, func(i interface{}) bool {
+    return i.(int) > 9
+  }))
+
+  l1, l2 = SplitAt(NewTuple(), 3)
+  assert.True(t, l1.IsEmpty() && l2.IsEmpty())
+}
+
 func TestFoldl(t *testing.T) {
 
   assert.Equal(t, Foldl(createSet(), 0, func(acu, item interface{}) interface{} {
@@ -0,0 +1,278 @@
+package generic
+
+import (
+  "maps"
+  "slices"
+)
+
+// SliceSeq A Sequence view of a slice. The items are not copied
+type SliceSeq[T any] struct {
+  s []T
+}
+
+// SliceIterator Iterator over a SliceSeq
+type SliceIterator[T any] struct {
+  s   []T
+  pos int
+}
+
+// SliceOf Return a Sequence view of s. Changes to the items of s are visible through the view
+func SliceOf[T any](s []T) *SliceSeq[T] {
+  return &SliceSeq[T]{s: s}
+}
+
+// Slice Return the underlying slice. No copy is done
+func (seq *SliceSeq[T]) Slice() []T {
+  return seq.s
+}
+
+// Append one or more elements to the underlying slice
+func (seq *SliceSeq[T]) Append(item T, items ...T) *SliceSeq[T] {
+  seq.s = append(append(seq.s, item), items...)
+  return seq
+}
+
+// Traverse the slice and execute operation on each element. It stops if operation returns false
+func (seq *SliceSeq[T]) Traverse(operation func(T) bool) bool {
+  for _, item := range seq.s {
+    if !operation(item) {
+      return false
+    }
+  }
+  return true
+}
+
+// Size Return the length of the slice
+func (seq *SliceSeq[T]) Size() int {
+  return len(seq.s)
+}
+
+// IsEmpty Return true if the slice is empty
+func (seq *SliceSeq[T]) IsEmpty() bool {
+  return len(seq.s) == 0
+}
+
+// Nth Return the n-th element of the slice
+func (seq *SliceSeq[T]) Nth(i int) T {
+  return seq.s[i]
+}
+
+// CreateIterator Return an iterator to the slice compliant with the interface Sequence
+func (seq *SliceSeq[T]) CreateIterator() SequentialIterator[T] {
+  return &SliceIterator[T]{s: seq.s}
+}
+
+// ResetFirst Reset the iterator to the first element
+func (it *SliceIterator[T]) ResetFirst() {
+  it.pos = 0
+}
+
+// HasCurr Return true if the iterator is on a element
+func (it *SliceIterator[T]) HasCurr() bool {
+  return it.pos < len(it.s)
+}
+
+// GetCurr Return the element on which the iterator is positioned
+func (it *SliceIterator[T]) GetCurr() T {
+  return it.s[it.pos]
+}
+
+// Next Advance the iterator to the next element
+func (it *SliceIterator[T]) Next() {
+  it.pos++
+}
+
+// MapSeq A Sequence view of a map whose items are the Pairs (key, value). As for a range loop over
+// the map, the order of the pairs is unspecified. The map is not copied
+type MapSeq[K comparable, V any] struct {
+  m map[K]V
+}
+
+// MapIterator Iterator over a MapSeq. The keys are taken when the iterator is reset, so the map
+// should not be modified while the iterator is used
+type MapIterator[K comparable, V any] struct {
+  m    map[K]V
+  keys []K
+  pos  int
+}
+
+// MapOf Return a Sequence view of m
+func MapOf[K comparable, V any](m map[K]V) *MapSeq[K, V] {
+  return &MapSeq[K, V]{m: m}
+}
+
+// Map Return the underlying map. No copy is done
+func (seq *MapSeq[K, V]) Map() map[K]V {
+  return seq.m
+}
+
+// Put Associate value to key in the underlying map
+func (seq *MapSeq[K, V]) Put(key K, value V) *MapSeq[K, V] {
+  seq.m[key] = value
+  return seq
+}
+
+// Traverse the map and execute operation on each pair. It stops if operation returns false
+func (seq *MapSeq[K, V]) Traverse(operation func(Pair[K, V]) bool) bool {
+  for k, v := range seq.m {
+    if !operation(Pair[K, V]{Item1: k, Item2: v}) {
+      return false
+    }
+  }
+  return true
+}
+
+// Size Return the number of keys of the map
+func (seq *MapSeq[K, V]) Size() int {
+  return len(seq.m)
+}
+
+// IsEmpty Return true if the map is empty
+func (seq *MapSeq[K, V]) IsEmpty() bool {
+  return len(seq.m) == 0
+}
+
+// CreateIterator Return an iterator to the map compliant with the interface Sequence
+func (seq *MapSeq[K, V]) CreateIterator() SequentialIterator[Pair[K, V]] {
+  it := &MapIterator[K, V]{m: seq.m}
+  it.ResetFirst()
+  return it
+}
+
+// ResetFirst Reset the iterator to the first pair
+func (it *MapIterator[K, V]) ResetFirst() {
+  it.keys = slices.Collect(maps.Keys(it.m))
+  it.pos = 0
+}
+
+// HasCurr Return true if the iterator is on a pair
+func (it *MapIterator[K, V]) HasCurr() bool {
+  return it.pos < len(it.keys)
+}
+
+// GetCurr Return the pair on which the iterator is positioned
+func (it *MapIterator[K, V]) GetCurr() Pair[K, V] {
+  key := it.keys[it.pos]
+  return Pair[K, V]{Item1: key, Item2: it.m[key]}
+}
+
+// Next Advance the iterator to the next pair
+func (it *MapIterator[K, V]) Next() {
+  it.pos++
+}
+
+// ChanSeq A single pass Sequence view of a channel. As ReaderSeq, it is its own iterator: every item
+// is received when it is requested and is not kept afterwards, so a long running channel does not
+// accumulate items. Because a channel cannot be rewound, ResetFirst does nothing, and Traverse, Size
+// and IsEmpty start at the current item. Size and a complete traversal block until the channel is
+// closed. A ChanSeq is not safe for concurrent use
+type ChanSeq[T any] struct {
+  ch      <-chan T
+  extra   []T // appended items, yielded after the ones of the channel
+  curr    T
+  ok      bool
+  pending bool // the current item has not been received yet
+  closed  bool
+}
+
+// ChanOf Return a Sequence view of ch
+func ChanOf[T any](ch <-chan T) *ChanSeq[T] {
+  return &ChanSeq[T]{ch: ch, pending: true}
+}
+
+// fetch Receive the current item if it has not been received yet
+func (seq *ChanSeq[T]) fetch() {
+
+  if !seq.pending {
+    return
+  }
+  seq.pending = false
+
+  if !seq.closed {
+    if item, ok := <-seq.ch; ok {
+      seq.curr, seq.ok = item, true
+      return
+    }
+    seq.closed = true
+  }
+
+  if seq.ok = len(seq.extra) > 0; seq.ok {
+    seq.curr, seq.extra = seq.extra[0], seq.extra[1:]
+  }
+}
+
+// Append one or more elements to be yielded after the channel is closed
+func (seq *ChanSeq[T]) Append(item T, items ...T) *ChanSeq[T] {
+  seq.extra = append(append(seq.extra, item), items...)
+  return seq
+}
+
+// ResetFirst Do nothing; a channel cannot be received again
+func (seq *ChanSeq[T]) ResetFirst() {
+}
+
+// HasCurr Return true if the iterator is on an item. It blocks until the item is received or the
+// channel is closed
+func (seq *ChanSeq[T]) HasCurr() bool {
+  seq.fetch()
+  return seq.ok
+}
+
+// GetCurr Return the current item
+func (seq *ChanSeq[T]) GetCurr() T {
+  seq.fetch()
+  return seq.curr
+}
+
+// Next Advance to the next item. It is received when it is requested
+func (seq *ChanSeq[T]) Next() {
+  seq.fetch()
+  seq.pending = seq.ok
+}
+
+// Traverse the remaining items and execute operation on each one. It stops if operation returns false;
+// the item on which it stops is consumed, but the next one is not read yet
+func (seq *ChanSeq[T]) Traverse(operation func(T) bool) bool {
+  for ; seq.HasCurr(); seq.Next() {
+    if !operation(seq.GetCurr()) {
+      seq.Next()
+      return false
+    }
+  }
+  return true
+}
+
+// Size Receive until the channel is closed and return the number of remaining items
+func (seq *ChanSeq[T]) Size() int {
+  n := 0
+  for ; seq.HasCurr(); seq.Next() {
+    n++
+  }
+  return n
+}
+
+// IsEmpty Return true if there are no remaining items. It blocks until an item is received or the
+// channel is closed
+func (seq *ChanSeq[T]) IsEmpty() bool {
+  return !seq.HasCurr()
+}
+
+// CreateIterator Return the sequence itself, which is its own iterator
+func (seq *ChanSeq[T]) CreateIterator() SequentialIterator[T] {
+  return seq
+}
+
+// ToSlice Return a new slice with the items of seq
+func ToSlice[T any](seq Sequence[T]) []T {
+  return toSlice(seq)
+}
+
+// ToMap Return a new map from the pairs of seq. If a key is repeated, the value of its last pair is
+// kept
+func ToMap[K comparable, V any](seq Sequence[Pair[K, V]]) map[K]V {
+  ret := make(map[K]V, sizeHint(seq))
+  ForEach(seq, func(p Pair[K, V]) {
+    ret[p.Item1] = p.Item2
+  })
+  return ret
+}
@@ -0,0 +1,69 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "slices"
+  "strings"
+  "testing"
+)
+
+func TestSliceOf(t *testing.T) {
+
+  words := []string{"go", "is", "fun"}
+  seq := SliceOf(words)
+  upper := Map[string, string](seq, strings.ToUpper)
+  assert.Equal(t, upper.ToSlice(), []string{"GO", "IS", "FUN"})
+
+  // random access is available through the view
+  assert.Equal(t, Foldr[string, string](seq, "", func(w, acu string) string { return acu + w }), "funisgo")
+
+  seq.Append("!")
+  assert.Equal(t, seq.Slice(), []string{"go", "is", "fun", "!"})
+  assert.True(t, SliceOf[int](nil).IsEmpty())
+}
+
+func TestMapOf(t *testing.T) {
+
+  m := map[int]string{1: "one", 2: "two", 3: "three"}
+  seq := MapOf(m)
+  keys := Map[Pair[int, string], int](seq, func(p Pair[int, string]) int { return p.Item1 }).ToSlice()
+  slices.Sort(keys)
+  assert.Equal(t, keys, []int{1, 2, 3})
+
+  seq.Put(4, "four")
+  assert.Equal(t, ToMap[int, string](seq), m)
+
+  odd := Filter[Pair[int, string]](seq, func(p Pair[int, string]) bool { return p.Item1%2 == 1 })
+  assert.Equal(t, ToMap[int, string](odd), map[int]string{1: "one", 3: "three"})
+  assert.Equal(t, odd.Size(), 2)
+}
+
+func TestChanOf(t *testing.T) {
+
+  ch := make(chan int, 3)
+  seq := ChanOf(ch)
+  ch <- 1
+  ch <- 2
+
+  // only the received items are required
+  it := seq.CreateIterator()
+  assert.Equal(t, it.GetCurr(), 1)
+  it.Next()
+  assert.Equal(t, it.GetCurr(), 2)
+
+  // the sequence is its own iterator, so the consumed items are gone
+  ch <- 3
+  close(ch)
+  seq.Append(4)
+  assert.Equal(t, Map[int, int](seq, func(i int) int { return i * i }).ToSlice(), []int{4, 9, 16})
+  assert.False(t, it.HasCurr())
+  assert.True(t, seq.IsEmpty())
+  assert.Equal(t, ToSlice[int](seq), []int{})
+}
+
+func TestToSliceToMap(t *testing.T) {
+
+  assert.Equal(t, ToSlice(Take(createSet(), 3)), []int{0, 1, 2})
+  pairs := Zip[string, int](NewList("a", "b", "a"), NewList(1, 2, 3))
+  assert.Equal(t, ToMap[string, int](pairs), map[string]int{"a": 3, "b": 2})
+}
@@ -0,0 +1,169 @@
+package generic
+
+import (
+  "context"
+  "fmt"
+  "sync"
+)
+
+// Every stage below runs in its own goroutines and returns channels buffered with buffer items. An
+// output channel is closed when the input channels are closed and all their items have been
+// delivered, or when ctx is done, in which case the pending items are discarded. The input channels
+// are never closed by the stages
+
+// send Send item through out unless ctx is done first. Return false if ctx is done
+func send[T any](ctx context.Context, out chan<- T, item T) bool {
+  select {
+  case out <- item:
+    return true
+  case <-ctx.Done():
+    return false
+  }
+}
+
+// receive Receive an item from in unless ctx is done first. The second result is false if in is
+// closed or ctx is done
+func receive[T any](ctx context.Context, in <-chan T) (T, bool) {
+  if ctx.Err() != nil {
+    var zero T
+    return zero, false
+  }
+  select {
+  case item, ok := <-in:
+    return item, ok
+  case <-ctx.Done():
+    var zero T
+    return zero, false
+  }
+}
+
+// MapChan Return a channel with the items received from in transformed with transformation
+func MapChan[T, U any](ctx context.Context, in <-chan T, buffer int, transformation func(T) U) <-chan U {
+
+  out := make(chan U, buffer)
+  go func() {
+    defer close(out)
+    for item, ok := receive(ctx, in); ok; item, ok = receive(ctx, in) {
+      if !send(ctx, out, transformation(item)) {
+        return
+      }
+    }
+  }()
+  return out
+}
+
+// FilterChan Return a channel with the items received from in satisfying predicate
+func FilterChan[T any](ctx context.Context, in <-chan T, buffer int, predicate func(T) bool) <-chan T {
+
+  out := make(chan T, buffer)
+  go func() {
+    defer close(out)
+    for item, ok := receive(ctx, in); ok; item, ok = receive(ctx, in) {
+      if predicate(item) && !send(ctx, out, item) {
+        return
+      }
+    }
+  }()
+  return out
+}
+
+// FanOut Distribute the items received from in among n channels. Every item is delivered to only one
+// of them, the first ready to accept it, so slow consumers receive less items
+func FanOut[T any](ctx context.Context, in <-chan T, n, buffer int) []<-chan T {
+
+  outs := make([]<-chan T, n)
+  for i := range outs {
+    out := make(chan T, buffer)
+    outs[i] = out
+    go func() {
+      defer close(out)
+      for item, ok := receive(ctx, in); ok; item, ok = receive(ctx, in) {
+        if !send(ctx, out, item) {
+          return
+        }
+      }
+    }()
+  }
+  return outs
+}
+
+// FanIn Return a channel with the items received from all the channels ins. The order of the items
+// of each channel is kept, but items of different channels are interleaved as they arrive
+func FanIn[T any](ctx context.Context, buffer int, ins ...<-chan T) <-chan T {
+
+  out := make(chan T, buffer)
+  var wg sync.WaitGroup
+  wg.Add(len(ins))
+  for _, in := range ins {
+    go func() {
+      defer wg.Done()
+      for item, ok := receive(ctx, in); ok; item, ok = receive(ctx, in) {
+        if !send(ctx, out, item) {
+          return
+        }
+      }
+    }()
+  }
+
+  go func() {
+    wg.Wait()
+    close(out)
+  }()
+  return out
+}
+
+// Batch Return a channel with tuples of size consecutive items received from in. When in is
+// closed, the remaining items are sent as a shorter tuple
+func Batch[T any](ctx context.Context, in <-chan T, size, buffer int) <-chan *Tuple[T] {
+
+  if size <= 0 {
+    panic(fmt.Sprintf("Invalid batch size = %d", size))
+  }
+
+  out := make(chan *Tuple[T], buffer)
+  go func() {
+    defer close(out)
+    batch := BuildTuple[T](0)
+    for item, ok := receive(ctx, in); ok; item, ok = receive(ctx, in) {
+      if batch.Append(item).Size() < size {
+        continue
+      }
+      if !send(ctx, out, batch) {
+        return
+      }
+      batch = BuildTuple[T](0)
+    }
+    if !batch.IsEmpty() && ctx.Err() == nil {
+      send(ctx, out, batch)
+    }
+  }()
+  return out
+}
+
+// Tee Return n channels, each one receiving every item received from in. An item is not received
+// from in until it has been delivered to all the channels, so the slowest consumer sets the pace
+func Tee[T any](ctx context.Context, in <-chan T, n, buffer int) []<-chan T {
+
+  outs := make([]chan T, n)
+  ret := make([]<-chan T, n)
+  for i := range outs {
+    outs[i] = make(chan T, buffer)
+    ret[i] = outs[i]
+  }
+
+  go func() {
+    defer func() {
+      for _, out := range outs {
+        close(out)
+      }
+    }()
+    for item, ok := receive(ctx, in); ok; item, ok = receive(ctx, in) {
+      for _, out := range outs {
+        if !send(ctx, out, item) {
+          return
+        }
+      }
+    }
+  }()
+  return ret
+}
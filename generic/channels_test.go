@@ -0,0 +1,87 @@
+package generic
+
+import (
+  "context"
+  "github.com/stretchr/testify/assert"
+  "slices"
+  "testing"
+)
+
+// produce Return a channel sending 0, 1, ..., n - 1 and then closed
+func produce(n int) <-chan int {
+  ch := make(chan int)
+  go func() {
+    defer close(ch)
+    for i := 0; i < n; i++ {
+      ch <- i
+    }
+  }()
+  return ch
+}
+
+func TestMapFilterChan(t *testing.T) {
+
+  ctx := context.Background()
+  evens := FilterChan(ctx, produce(N), 4, func(i int) bool { return i%2 == 0 })
+  squares := MapChan(ctx, evens, 4, func(i int) int { return i * i })
+
+  expected := Map(Filter(createSet(), func(i int) bool { return i%2 == 0 }), func(i int) int { return i * i })
+  assert.Equal(t, ToSlice[int](ChanOf(squares)), expected.ToSlice())
+}
+
+func TestFanOutFanIn(t *testing.T) {
+
+  ctx := context.Background()
+  workers := FanOut(ctx, produce(N), 4, 0)
+  assert.Equal(t, len(workers), 4)
+
+  doubled := make([]<-chan int, len(workers))
+  for i, w := range workers {
+    doubled[i] = MapChan(ctx, w, 0, func(i int) int { return 2 * i })
+  }
+
+  items := ToSlice[int](ChanOf(FanIn(ctx, 8, doubled...)))
+  slices.Sort(items)
+  assert.Equal(t, items, Map(createSet(), func(i int) int { return 2 * i }).ToSlice())
+
+  empty := FanIn[int](ctx, 0)
+  _, ok := <-empty
+  assert.False(t, ok)
+}
+
+func TestBatch(t *testing.T) {
+
+  batches := Batch(context.Background(), produce(10), 4, 1)
+  sizes := MapChan(context.Background(), batches, 0, (*Tuple[int]).Size)
+  assert.Equal(t, ToSlice[int](ChanOf(sizes)), []int{4, 4, 2})
+  assert.Panics(t, func() { Batch(context.Background(), produce(1), 0, 0) })
+}
+
+func TestTee(t *testing.T) {
+
+  outs := Tee(context.Background(), produce(N), 3, N)
+  for _, out := range outs {
+    assert.Equal(t, ToSlice[int](ChanOf(out)), toSlice(createSet()))
+  }
+}
+
+func TestChanCancel(t *testing.T) {
+
+  ctx, cancel := context.WithCancel(context.Background())
+  in := make(chan int) // never closed
+  out := MapChan(ctx, in, 0, func(i int) int { return i })
+  in <- 1
+  assert.Equal(t, <-out, 1)
+
+  cancel()
+  _, ok := <-out
+  assert.False(t, ok)
+
+  // all the outputs of the stages are closed on cancellation
+  for _, ch := range append(Tee(ctx, in, 2, 0), FanOut(ctx, in, 2, 0)...) {
+    _, ok := <-ch
+    assert.False(t, ok)
+  }
+  _, ok = <-Batch(ctx, in, 2, 0)
+  assert.False(t, ok)
+}
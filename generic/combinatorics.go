@@ -0,0 +1,294 @@
+package generic
+
+import (
+  "math"
+)
+
+// NextPermutation Rearrange in place the tuple into the lexicographically next greater permutation
+// according to less. If the tuple is the greatest permutation, then it is rearranged as the smallest
+// one (sorted) and false is returned
+func (tuple *Tuple[T]) NextPermutation(less func(i1, i2 T) bool) bool {
+  return tuple.stepPermutation(less)
+}
+
+// PrevPermutation Rearrange in place the tuple into the lexicographically previous permutation
+// according to less. If the tuple is the smallest permutation, then it is rearranged as the greatest
+// one (reverse sorted) and false is returned
+func (tuple *Tuple[T]) PrevPermutation(less func(i1, i2 T) bool) bool {
+  return tuple.stepPermutation(func(i1, i2 T) bool {
+    return less(i2, i1)
+  })
+}
+
+func (tuple *Tuple[T]) stepPermutation(less func(i1, i2 T) bool) bool {
+
+  n := tuple.Size()
+  if n < 2 {
+    return false
+  }
+
+  i := n - 2
+  for i >= 0 && !less(tuple.Nth(i), tuple.Nth(i+1)) {
+    i--
+  }
+
+  if i < 0 { // last permutation ==> wrap around to the first one
+    tuple.ReverseInPlace()
+    return false
+  }
+
+  j := n - 1
+  for !less(tuple.Nth(i), tuple.Nth(j)) {
+    j--
+  }
+
+  (*tuple.l)[i], (*tuple.l)[j] = (*tuple.l)[j], (*tuple.l)[i]
+  tuple.ReverseInterval(i+1, n-1)
+
+  return true
+}
+
+// CombinatorialIterator Iterator over arrangements (permutations, combinations, subsets) of the
+// items of a sequence. Each arrangement is returned as a new Tuple, so it may be kept by the caller.
+// The arrangements are generated lazily, one per call to Next
+type CombinatorialIterator[T any] struct {
+  items []T
+  idx   []int
+  ok    bool
+  first func(it *CombinatorialIterator[T]) bool // set idx to the first arrangement
+  next  func(it *CombinatorialIterator[T]) bool // advance idx to the next arrangement
+}
+
+func newCombinatorialIterator[T any](seq Sequence[T],
+  first, next func(it *CombinatorialIterator[T]) bool) *CombinatorialIterator[T] {
+
+  it := &CombinatorialIterator[T]{items: toSlice(seq), first: first, next: next}
+  it.ResetFirst()
+  return it
+}
+
+// ResetFirst Reset the iterator to the first arrangement
+func (it *CombinatorialIterator[T]) ResetFirst() {
+  it.ok = it.first(it)
+}
+
+// HasCurr Return true if the iterator is on an arrangement
+func (it *CombinatorialIterator[T]) HasCurr() bool {
+  return it.ok
+}
+
+// GetCurr Return a new tuple with the current arrangement
+func (it *CombinatorialIterator[T]) GetCurr() *Tuple[T] {
+  ret := BuildTuple[T](len(it.idx))
+  for i, pos := range it.idx {
+    ret.Set(i, it.items[pos])
+  }
+  return ret
+}
+
+// Next Advance the iterator to the next arrangement
+func (it *CombinatorialIterator[T]) Next() {
+  it.ok = it.ok && it.next(it)
+}
+
+// identity Set it.idx to 0, 1, ..., k - 1
+func (it *CombinatorialIterator[T]) identity(k int) {
+  it.idx = make([]int, k)
+  for i := range it.idx {
+    it.idx[i] = i
+  }
+}
+
+// NewPermutationIterator Return an iterator over the n! permutations of the positions of the items
+// of seq. Equal items are not merged, so they generate repeated permutations. Use NextPermutation on
+// a sorted tuple to get the distinct ones
+func NewPermutationIterator[T any](seq Sequence[T]) *CombinatorialIterator[T] {
+  return newCombinatorialIterator(seq, func(it *CombinatorialIterator[T]) bool {
+    it.identity(len(it.items))
+    return true
+  }, func(it *CombinatorialIterator[T]) bool {
+    return TupleOf(&it.idx).NextPermutation(Less[int])
+  })
+}
+
+// nextCombination Advance idx to the next k-combination of [0, n) in lexicographic order
+func nextCombination(idx []int, n int) bool {
+
+  k := len(idx)
+  i := k - 1
+  for i >= 0 && idx[i] == n-k+i {
+    i--
+  }
+  if i < 0 {
+    return false
+  }
+
+  idx[i]++
+  for j := i + 1; j < k; j++ {
+    idx[j] = idx[j-1] + 1
+  }
+  return true
+}
+
+// NewCombinationIterator Return an iterator over the k-combinations of the items of seq in
+// lexicographic order of their positions. There are none if k > seq.Size()
+func NewCombinationIterator[T any](seq Sequence[T], k int) *CombinatorialIterator[T] {
+  return newCombinatorialIterator(seq, func(it *CombinatorialIterator[T]) bool {
+    it.identity(max(k, 0))
+    return k >= 0 && k <= len(it.items)
+  }, func(it *CombinatorialIterator[T]) bool {
+    return nextCombination(it.idx, len(it.items))
+  })
+}
+
+// NewMultiCombinationIterator Return an iterator over the k-combinations with repetition of the
+// items of seq, that is, the multisets of size k, in lexicographic order of their positions
+func NewMultiCombinationIterator[T any](seq Sequence[T], k int) *CombinatorialIterator[T] {
+  return newCombinatorialIterator(seq, func(it *CombinatorialIterator[T]) bool {
+    it.idx = make([]int, max(k, 0))
+    return k == 0 || (k > 0 && len(it.items) > 0)
+  }, func(it *CombinatorialIterator[T]) bool {
+    n := len(it.items)
+    i := len(it.idx) - 1
+    for i >= 0 && it.idx[i] == n-1 {
+      i--
+    }
+    if i < 0 {
+      return false
+    }
+
+    it.idx[i]++
+    for j := i + 1; j < len(it.idx); j++ {
+      it.idx[j] = it.idx[i]
+    }
+    return true
+  })
+}
+
+// NewPowerSetIterator Return an iterator over the 2^n subsets of the items of seq. The subsets are
+// generated by increasing size and, for each size, in lexicographic order of the positions
+func NewPowerSetIterator[T any](seq Sequence[T]) *CombinatorialIterator[T] {
+  return newCombinatorialIterator(seq, func(it *CombinatorialIterator[T]) bool {
+    it.idx = []int{}
+    return true
+  }, func(it *CombinatorialIterator[T]) bool {
+    if nextCombination(it.idx, len(it.items)) {
+      return true
+    }
+    if len(it.idx) == len(it.items) {
+      return false
+    }
+    it.identity(len(it.idx) + 1)
+    return true
+  })
+}
+
+// arrangements Return a lazy stream over the arrangements of the iterators returned by create. Each
+// evaluation uses its own iterator
+func arrangements[T any](create func() *CombinatorialIterator[T]) *Stream[*Tuple[T]] {
+  return iteratorStream(func() SequentialIterator[*Tuple[T]] {
+    return create()
+  })
+}
+
+// Permutations Return a lazy stream of the permutations of the items of seq. See NewPermutationIterator
+func Permutations[T any](seq Sequence[T]) *Stream[*Tuple[T]] {
+  return arrangements(func() *CombinatorialIterator[T] {
+    return NewPermutationIterator(seq)
+  })
+}
+
+// Combinations Return a lazy stream of the k-combinations of the items of seq
+func Combinations[T any](seq Sequence[T], k int) *Stream[*Tuple[T]] {
+  return arrangements(func() *CombinatorialIterator[T] {
+    return NewCombinationIterator(seq, k)
+  })
+}
+
+// CombinationsWithRepetition Return a lazy stream of the k-combinations with repetition of the items
+// of seq
+func CombinationsWithRepetition[T any](seq Sequence[T], k int) *Stream[*Tuple[T]] {
+  return arrangements(func() *CombinatorialIterator[T] {
+    return NewMultiCombinationIterator(seq, k)
+  })
+}
+
+// PowerSet Return a lazy stream of the subsets of the items of seq
+func PowerSet[T any](seq Sequence[T]) *Stream[*Tuple[T]] {
+  return arrangements(func() *CombinatorialIterator[T] {
+    return NewPowerSetIterator(seq)
+  })
+}
+
+// NewProductIterator Return an iterator over the cartesian product of seqs. Each tuple has one item of
+// every sequence, in the order of seqs, and the tuples are generated in lexicographic order of the
+// positions, the last sequence varying fastest. The product of no sequences has only the empty tuple
+func NewProductIterator[T any](seqs ...Sequence[T]) *CombinatorialIterator[T] {
+
+  // the items of all the sequences are stored in a single slice; seqs[i] lies in [bounds[i], bounds[i+1])
+  items := make([]T, 0)
+  bounds := make([]int, 0, len(seqs)+1)
+  for _, seq := range seqs {
+    bounds = append(bounds, len(items))
+    items = append(items, toSlice(seq)...)
+  }
+  bounds = append(bounds, len(items))
+
+  it := &CombinatorialIterator[T]{items: items, first: func(it *CombinatorialIterator[T]) bool {
+    it.idx = append(make([]int, 0, len(seqs)), bounds[:len(seqs)]...)
+    for i := range it.idx {
+      if bounds[i] == bounds[i+1] {
+        return false
+      }
+    }
+    return true
+  }, next: func(it *CombinatorialIterator[T]) bool {
+    for i := len(it.idx) - 1; i >= 0; i-- {
+      if it.idx[i]++; it.idx[i] < bounds[i+1] {
+        return true
+      }
+      it.idx[i] = bounds[i]
+    }
+    return false
+  }}
+  it.ResetFirst()
+  return it
+}
+
+// CartesianProduct Return a lazy stream of the tuples of the cartesian product of seqs. See
+// NewProductIterator. The Size of the stream is computed with ProductSize, without generating the
+// tuples. Every evaluation reads all of seqs, so single pass sequences (ChanSeq, ReaderSeq) are
+// consumed by the first one
+func CartesianProduct[T any](seqs ...Sequence[T]) *Stream[*Tuple[T]] {
+  ret := arrangements(func() *CombinatorialIterator[T] {
+    return NewProductIterator(seqs...)
+  })
+  ret.size = func() int {
+    return ProductSize(seqs...)
+  }
+  return ret
+}
+
+// ProductSize Return the number of tuples of the cartesian product of seqs, that is the product of
+// their sizes, or math.MaxInt if the product overflows int. The sizes are taken with Size, which is
+// O(n) for some sequences, such as List, and consumes the single pass ones
+func ProductSize[T any](seqs ...Sequence[T]) int {
+
+  sizes := make([]int, 0, len(seqs))
+  for _, seq := range seqs {
+    size := seq.Size()
+    if size == 0 {
+      return 0
+    }
+    sizes = append(sizes, size)
+  }
+
+  n := 1
+  for _, size := range sizes {
+    if n > math.MaxInt/size {
+      return math.MaxInt
+    }
+    n *= size
+  }
+  return n
+}
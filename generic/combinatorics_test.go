@@ -0,0 +1,95 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "math"
+  "testing"
+)
+
+func collect[T any](stream *Stream[*Tuple[T]]) [][]T {
+  return Map[*Tuple[T], []T](stream, (*Tuple[T]).ToSlice).ToSlice()
+}
+
+func TestNextPermutationCount(t *testing.T) {
+
+  tuple := NewTuple(1, 2, 3, 4, 5)
+  n := 1
+  for tuple.NextPermutation(Less[int]) {
+    n++
+  }
+  assert.Equal(t, n, 120)
+  assert.Equal(t, tuple.ToSlice(), []int{1, 2, 3, 4, 5})
+
+  for tuple.PrevPermutation(Less[int]) {
+    t.Fatal("1 2 3 4 5 has no previous permutation")
+  }
+  assert.Equal(t, tuple.ToSlice(), []int{5, 4, 3, 2, 1})
+}
+
+func TestCombinatorialStreams(t *testing.T) {
+
+  items := NewTuple('a', 'b', 'c')
+
+  assert.Equal(t, Permutations[rune](items).Size(), 6)
+  assert.Equal(t, collect(Combinations[rune](items, 2)), [][]rune{{'a', 'b'}, {'a', 'c'}, {'b', 'c'}})
+  assert.Equal(t, CombinationsWithRepetition[rune](items, 3).Size(), 10)
+  assert.Equal(t, collect(PowerSet[rune](items).Drop(6)), [][]rune{{'b', 'c'}, {'a', 'b', 'c'}})
+  assert.True(t, Combinations[rune](items, -1).IsEmpty())
+
+  it := NewCombinationIterator[rune](items, 1)
+  it.Next()
+  it.Next()
+  assert.Equal(t, it.GetCurr().ToSlice(), []rune{'c'})
+  it.Next()
+  assert.False(t, it.HasCurr())
+  it.ResetFirst()
+  assert.Equal(t, it.GetCurr().ToSlice(), []rune{'a'})
+}
+
+func TestCartesianProduct(t *testing.T) {
+
+  a, b := NewTuple(1, 2), NewList(10, 20, 30)
+  assert.Equal(t, ProductSize[int](a, b, a), 12)
+  assert.Equal(t, collect(CartesianProduct[int](a, b)),
+    [][]int{{1, 10}, {1, 20}, {1, 30}, {2, 10}, {2, 20}, {2, 30}})
+  assert.Equal(t, collect(CartesianProduct[int](a)), [][]int{{1}, {2}})
+  assert.Equal(t, collect(CartesianProduct[int]()), [][]int{{}})
+  assert.True(t, CartesianProduct[int](a, NewList[int]()).IsEmpty())
+
+  // the size is known without generating the tuples and it saturates instead of overflowing
+  big := SliceOf(make([]int, 1<<20))
+  assert.Equal(t, ProductSize[int](big, big), 1<<40)
+  assert.Equal(t, CartesianProduct[int](big, big, big, big).Size(), math.MaxInt)
+  assert.Equal(t, ProductSize[int](big, big, big, big, NewList[int]()), 0)
+
+  it := NewProductIterator[int](a, a)
+  for i := 0; i < 3; i++ {
+    it.Next()
+  }
+  assert.Equal(t, it.GetCurr().ToSlice(), []int{2, 2})
+  it.Next()
+  assert.False(t, it.HasCurr())
+}
+
+func TestCombinatorialStreamsOverlap(t *testing.T) {
+
+  p := Permutations[int](NewList(1, 2, 3))
+  pairs := Zip[*Tuple[int], *Tuple[int]](p, p)
+  assert.Equal(t, pairs.Size(), 6)
+  assert.True(t, All[Pair[*Tuple[int], *Tuple[int]]](pairs, func(pair Pair[*Tuple[int], *Tuple[int]]) bool {
+    return pair.Item1.Equal(pair.Item2)
+  }))
+
+  s := PowerSet[int](NewList(1, 2))
+  assert.Equal(t, Zip[*Tuple[int], *Tuple[int]](s, s).Size(), 4)
+}
+
+func TestCartesianProductOverlap(t *testing.T) {
+
+  product := CartesianProduct[int](NewList(1, 2), NewList(3, 4))
+  pairs := Zip[*Tuple[int], *Tuple[int]](product, product)
+  assert.Equal(t, pairs.Size(), 4)
+  assert.True(t, All[Pair[*Tuple[int], *Tuple[int]]](pairs, func(pair Pair[*Tuple[int], *Tuple[int]]) bool {
+    return pair.Item1.Equal(pair.Item2)
+  }))
+}
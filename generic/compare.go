@@ -0,0 +1,247 @@
+package generic
+
+import (
+  "encoding/binary"
+  "fmt"
+  "hash"
+  "hash/fnv"
+  "math"
+  "reflect"
+)
+
+// EqualFunc Return true if both tuples have the same size and eq is true for every pair of items in
+// the same position
+func (tuple *Tuple[T]) EqualFunc(other *Tuple[T], eq func(i1, i2 T) bool) bool {
+
+  items, otherItems := tuple.items(), other.items()
+  if len(items) != len(otherItems) {
+    return false
+  }
+
+  for i := range items {
+    if !eq(items[i], otherItems[i]) {
+      return false
+    }
+  }
+  return true
+}
+
+// Equal Return true if both tuples have the same size and their items in the same position are
+// deeply equal, as reflect.DeepEqual defines it
+func (tuple *Tuple[T]) Equal(other *Tuple[T]) bool {
+  return tuple.EqualFunc(other, func(i1, i2 T) bool {
+    return reflect.DeepEqual(i1, i2)
+  })
+}
+
+// Compare Compare lexicographically the tuples according to less, which orders their items. Return
+// -1 if tuple goes before other, 1 if it goes after and 0 if they are equivalent. A proper prefix
+// goes before the longer tuple
+func (tuple *Tuple[T]) Compare(other *Tuple[T], less func(i1, i2 T) bool) int {
+
+  items, otherItems := tuple.items(), other.items()
+  for i := 0; i < len(items) && i < len(otherItems); i++ {
+    if less(items[i], otherItems[i]) {
+      return -1
+    }
+    if less(otherItems[i], items[i]) {
+      return 1
+    }
+  }
+
+  switch {
+  case len(items) < len(otherItems):
+    return -1
+  case len(items) > len(otherItems):
+    return 1
+  }
+  return 0
+}
+
+// Less Return true if tuple goes lexicographically before other according to less
+func (tuple *Tuple[T]) Less(other *Tuple[T], less func(i1, i2 T) bool) bool {
+  return tuple.Compare(other, less) < 0
+}
+
+// Hash Return a hash of the items of the tuple. For acyclic items it is consistent with Equal: deeply
+// equal tuples have the same hash. The hash depends only on the values, so it is stable between
+// executions, except for items holding channels or unsafe pointers, which are hashed by address. As
+// reflect.DeepEqual, it remembers the visited pointers, slices and maps, so shared acyclic values are
+// hashed once and cyclic values end. However, a cycle is hashed by its length, whereas DeepEqual
+// considers equal any two cycles of equal items, so deeply equal cyclic items may hash differently
+func (tuple *Tuple[T]) Hash() uint64 {
+
+  h := fnv.New64a()
+  hasher := valueHasher{hashes: map[visit]uint64{}, active: map[visit]bool{}}
+  writeUint(h, uint64(len(tuple.items())))
+  for _, item := range tuple.items() {
+    hasher.hashValue(h, reflect.ValueOf(&item).Elem())
+  }
+  return h.Sum64()
+}
+
+func writeUint(h hash.Hash64, n uint64) {
+  h.Write(binary.LittleEndian.AppendUint64(nil, n))
+}
+
+func writeFloat(h hash.Hash64, f float64) {
+  if f == 0 { // -0 == 0
+    f = 0
+  }
+  writeUint(h, math.Float64bits(f))
+}
+
+// visit A reference already met while hashing. The length tells apart slices sharing their array
+type visit struct {
+  ptr uintptr
+  len int
+  typ reflect.Type
+}
+
+// valueHasher Hash state shared by the items of a tuple. hashes keeps the hash of every referenced
+// value already hashed and active the references being hashed, whose revisit means a cycle. cycles
+// counts the revisits
+type valueHasher struct {
+  hashes map[visit]uint64
+  active map[visit]bool
+  cycles int
+}
+
+// hashRef Write to h the hash of the value referenced by v. Since the hash of the value is written
+// instead of the value, shared and copied values write the same. The hash is remembered only if no
+// cycle was closed while computing it; otherwise it depends on the references that were active and
+// is computed again each time
+func (hasher *valueHasher) hashRef(h hash.Hash64, v reflect.Value, hashTarget func(h hash.Hash64)) {
+
+  key := visit{ptr: v.Pointer(), typ: v.Type()}
+  if v.Kind() == reflect.Slice {
+    key.len = v.Len()
+  }
+
+  if hasher.active[key] { // cycle
+    hasher.cycles++
+    h.Write([]byte{0xff})
+    return
+  }
+
+  sum, ok := hasher.hashes[key]
+  if !ok {
+    target := fnv.New64a()
+    cycles := hasher.cycles
+    hasher.active[key] = true
+    hashTarget(target)
+    delete(hasher.active, key)
+    sum = target.Sum64()
+    if hasher.cycles == cycles {
+      hasher.hashes[key] = sum
+    }
+  }
+  writeUint(h, sum)
+}
+
+// hashValue Write v to h in a way that deeply equal values write the same bytes
+func (hasher *valueHasher) hashValue(h hash.Hash64, v reflect.Value) {
+
+  if !v.IsValid() {
+    h.Write([]byte{0})
+    return
+  }
+  h.Write([]byte{byte(v.Kind())})
+
+  switch v.Kind() {
+  case reflect.Bool:
+    if v.Bool() {
+      h.Write([]byte{1})
+    } else {
+      h.Write([]byte{0})
+    }
+  case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+    writeUint(h, uint64(v.Int()))
+  case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+    writeUint(h, v.Uint())
+  case reflect.Float32, reflect.Float64:
+    writeFloat(h, v.Float())
+  case reflect.Complex64, reflect.Complex128:
+    writeFloat(h, real(v.Complex()))
+    writeFloat(h, imag(v.Complex()))
+  case reflect.String:
+    writeUint(h, uint64(v.Len()))
+    h.Write([]byte(v.String()))
+  case reflect.Array:
+    writeUint(h, uint64(v.Len()))
+    for i := 0; i < v.Len(); i++ {
+      hasher.hashValue(h, v.Index(i))
+    }
+  case reflect.Slice:
+    writeUint(h, uint64(v.Len()))
+    if v.Len() > 0 {
+      hasher.hashRef(h, v, func(h hash.Hash64) {
+        for i := 0; i < v.Len(); i++ {
+          hasher.hashValue(h, v.Index(i))
+        }
+      })
+    }
+  case reflect.Struct:
+    h.Write([]byte(v.Type().String()))
+    for i := 0; i < v.NumField(); i++ {
+      hasher.hashValue(h, v.Field(i))
+    }
+  case reflect.Interface:
+    if !v.IsNil() {
+      h.Write([]byte(v.Elem().Type().String()))
+    }
+    hasher.hashValue(h, v.Elem())
+  case reflect.Pointer:
+    if !v.IsNil() {
+      hasher.hashRef(h, v, func(h hash.Hash64) { hasher.hashValue(h, v.Elem()) })
+    }
+  case reflect.Map: // the order of the entries is unspecified, so their hashes are added
+    writeUint(h, uint64(v.Len()))
+    if v.Len() > 0 {
+      hasher.hashRef(h, v, func(h hash.Hash64) {
+        var sum uint64
+        for it := v.MapRange(); it.Next(); {
+          entry := fnv.New64a()
+          hasher.hashValue(entry, it.Key())
+          hasher.hashValue(entry, it.Value())
+          sum += entry.Sum64()
+        }
+        writeUint(h, sum)
+      })
+    }
+  case reflect.Chan, reflect.UnsafePointer:
+    writeUint(h, uint64(v.Pointer()))
+  case reflect.Func: // functions are only deeply equal if both are nil
+    if v.IsNil() {
+      h.Write([]byte{0})
+    } else {
+      writeUint(h, uint64(v.Pointer()))
+    }
+  }
+}
+
+// TupleKey A comparable value built from the items of a tuple, so that tuples can be used as keys of
+// maps or compared with ==. Two keys are equal if their tuples have the same size and equal items
+// (with ==) in the same positions
+type TupleKey struct {
+  array interface{}
+}
+
+// Key Return the TupleKey of the tuple. It panics if some item is not comparable
+func (tuple *Tuple[T]) Key() TupleKey {
+
+  itemType := reflect.TypeFor[T]()
+  if !itemType.Comparable() {
+    panic(fmt.Sprintf("%s is not comparable", itemType))
+  }
+
+  items := tuple.items()
+  array := reflect.New(reflect.ArrayOf(len(items), itemType)).Elem()
+  for i, item := range items {
+    if v := reflect.ValueOf(item); v.IsValid() && !v.Comparable() {
+      panic(fmt.Sprintf("item %d of type %T is not comparable", i, item))
+    }
+    array.Index(i).Set(reflect.ValueOf(&item).Elem())
+  }
+  return TupleKey{array: array.Interface()}
+}
@@ -0,0 +1,98 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "math"
+  "testing"
+)
+
+func TestTupleEqual(t *testing.T) {
+
+  type node struct {
+    label string
+    next  *node
+  }
+  n1 := &node{"a", &node{"b", nil}}
+  n2 := &node{"a", &node{"b", nil}}
+  assert.True(t, NewTuple(n1).Equal(NewTuple(n2)))
+  assert.Equal(t, NewTuple(n1).Hash(), NewTuple(n2).Hash())
+
+  n2.next.label = "c"
+  assert.False(t, NewTuple(n1).Equal(NewTuple(n2)))
+
+  near := func(f1, f2 float64) bool { return math.Abs(f1-f2) < 1e-9 }
+  a, b := 0.1, 0.2
+  assert.True(t, NewTuple(a+b).EqualFunc(NewTuple(0.3), near))
+  assert.False(t, NewTuple(a+b).Equal(NewTuple(0.3)))
+}
+
+func TestTupleCompare(t *testing.T) {
+
+  words := []*Tuple[string]{NewTuple("b"), NewTuple("a", "z"), NewTuple("a"), NewTuple[string]()}
+  sorted := Sorted[*Tuple[string]](SliceOf(words), func(t1, t2 *Tuple[string]) bool {
+    return t1.Less(t2, Less[string])
+  })
+  assert.Equal(t, Map[*Tuple[string], string](sorted, (*Tuple[string]).String).ToSlice(),
+    []string{"()", "(a)", "(a, z)", "(b)"})
+
+  byLen := func(s1, s2 string) bool { return len(s1) < len(s2) }
+  assert.Equal(t, NewTuple("ab", "c").Compare(NewTuple("xy", "z"), byLen), 0)
+}
+
+func TestTupleHash(t *testing.T) {
+
+  m1 := map[string]int{"a": 1, "b": 2, "c": 3}
+  m2 := map[string]int{"c": 3, "b": 2, "a": 1}
+  assert.Equal(t, NewTuple(m1).Hash(), NewTuple(m2).Hash())
+  assert.Equal(t, NewTuple(0.0).Hash(), NewTuple(math.Copysign(0, -1)).Hash())
+  assert.NotEqual(t, NewTuple("ab", "c").Hash(), NewTuple("a", "bc").Hash())
+
+  // stable value: it must not change between executions
+  assert.Equal(t, NewTuple(1, 2, 3).Hash(), uint64(13076662267644182404))
+  assert.Equal(t, NewTuple("a").Hash(), uint64(10002301544905632796))
+
+  // cyclic values end
+  type cycle struct{ next *cycle }
+  c := &cycle{}
+  c.next = c
+  assert.NotPanics(t, func() { NewTuple(c).Hash() })
+
+  // the hashes computed inside a cycle are not reused out of it, where they would differ
+  p1, p2 := &cycle{}, &cycle{}
+  p1.next, p2.next = p2, p1
+  q1, q2 := &cycle{}, &cycle{}
+  q1.next, q2.next = q2, q1
+  r1, r2 := &cycle{}, &cycle{}
+  r1.next, r2.next = r2, r1
+  assert.True(t, NewTuple(p1, p2).Equal(NewTuple(q1, r2)))
+  assert.Equal(t, NewTuple(p1, p2).Hash(), NewTuple(q1, r2).Hash())
+
+  // but the hash of a cycle depends on its length, which DeepEqual ignores
+  assert.True(t, NewTuple(c).Equal(NewTuple(p1)))
+  assert.NotEqual(t, NewTuple(c).Hash(), NewTuple(p1).Hash())
+
+  // a revisit of a reference being hashed ends there, so self references do not blow up the work
+  self := make([]interface{}, 1000)
+  for i := range self {
+    self[i] = &self
+  }
+  assert.NotPanics(t, func() { NewTuple[interface{}](self).Hash() })
+
+  // shared and copied values have the same hash
+  x, y := []int{1, 2}, []int{1, 2}
+  assert.Equal(t, NewTuple([][]int{x, x}).Hash(), NewTuple([][]int{x, y}).Hash())
+}
+
+func TestTupleKey(t *testing.T) {
+
+  seen := make(map[TupleKey]bool)
+  for _, p := range []*Tuple[int]{NewTuple(1, 2), NewTuple(2, 1), NewTuple(1, 2)} {
+    seen[p.Key()] = true
+  }
+  assert.Equal(t, len(seen), 2)
+  assert.True(t, seen[NewTuple(2, 1).Key()])
+  assert.False(t, seen[NewTuple(1).Key()])
+
+  assert.Panics(t, func() { NewTuple([]int{1}).Key() })
+  assert.Panics(t, func() { NewTuple[interface{}](map[int]int{}).Key() })
+}
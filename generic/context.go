@@ -0,0 +1,89 @@
+package generic
+
+import (
+  "context"
+)
+
+// traverseCtx Traverse seq executing operation on each item until ctx is done. Return ctx.Err() if
+// the traversal was interrupted, nil otherwise
+func traverseCtx[T any](ctx context.Context, seq Sequence[T], operation func(T)) error {
+
+  var err error
+  seq.Traverse(func(item T) bool {
+    if err = ctx.Err(); err != nil {
+      return false
+    }
+    operation(item)
+    return true
+  })
+  return err
+}
+
+// ForEachCtx Execute operation receiving every item of the sequence. The traversal stops as soon
+// as ctx is done, in which case ctx.Err() is returned
+func ForEachCtx[T any](ctx context.Context, seq Sequence[T], operation func(T)) error {
+  return traverseCtx(ctx, seq, operation)
+}
+
+// AllCtx Return true if all the elements of the sequence meets predicate. If ctx is done before
+// the answer is known, then it returns false and ctx.Err()
+func AllCtx[T any](ctx context.Context, seq Sequence[T], predicate func(T) bool) (bool, error) {
+
+  var err error
+  ret := seq.Traverse(func(item T) bool {
+    if err = ctx.Err(); err != nil {
+      return false
+    }
+    return predicate(item)
+  })
+  return ret && err == nil, err
+}
+
+// MapCtx Return a new list with the items of sequence transformed with transformation. If ctx is
+// done during the traversal, then it returns the items transformed so far and ctx.Err()
+func MapCtx[T, U any](ctx context.Context, seq Sequence[T], transformation func(T) U) (*List[U], error) {
+
+  ret := NewList[U]()
+  err := traverseCtx(ctx, seq, func(item T) {
+    ret.Append(transformation(item))
+  })
+  return ret, err
+}
+
+// MapIfCtx Return a new list with the items of sequence satisfying predicate transformed with
+// transformation. If ctx is done during the traversal, then it returns the partial list and ctx.Err()
+func MapIfCtx[T, U any](ctx context.Context, seq Sequence[T], transformation func(T) U,
+  predicate func(T) bool) (*List[U], error) {
+
+  ret := NewList[U]()
+  err := traverseCtx(ctx, seq, func(item T) {
+    if predicate(item) {
+      ret.Append(transformation(item))
+    }
+  })
+  return ret, err
+}
+
+// FilterCtx Return a list containing the items satisfying predicate. If ctx is done during the
+// traversal, then it returns the items selected so far and ctx.Err()
+func FilterCtx[T any](ctx context.Context, seq Sequence[T], predicate func(T) bool) (*List[T], error) {
+
+  ret := NewList[T]()
+  err := traverseCtx(ctx, seq, func(item T) {
+    if predicate(item) {
+      ret.Append(item)
+    }
+  })
+  return ret, err
+}
+
+// FoldlCtx Return f(in, ..., f(i2, f(i1, initVal) ... )). If ctx is done during the traversal,
+// then it returns the accumulated value so far and ctx.Err()
+func FoldlCtx[T, A any](ctx context.Context, seq Sequence[T], initVal A, f func(acu A, item T) A) (A, error) {
+
+  retVal := initVal
+  err := traverseCtx(ctx, seq, func(item T) {
+    retVal = f(retVal, item)
+  })
+  return retVal, err
+}
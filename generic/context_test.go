@@ -0,0 +1,36 @@
+package generic
+
+import (
+  "context"
+  "github.com/stretchr/testify/assert"
+  "testing"
+  "time"
+)
+
+func TestFoldlCtx(t *testing.T) {
+
+  ctx, cancel := context.WithCancel(context.Background())
+  sum, err := FoldlCtx(ctx, createSet(), 0, func(acu, i int) int {
+    if i == 10 {
+      cancel()
+    }
+    return acu + i
+  })
+  assert.ErrorIs(t, err, context.Canceled)
+  assert.Equal(t, sum, 55)
+}
+
+func TestFilterCtxDeadline(t *testing.T) {
+
+  ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+  defer cancel()
+  <-ctx.Done()
+
+  l, err := FilterCtx(ctx, createSet(), func(int) bool { return true })
+  assert.ErrorIs(t, err, context.DeadlineExceeded)
+  assert.True(t, l.IsEmpty())
+
+  m, err := MapCtx(context.Background(), createSet(), func(i int) int { return i + 1 })
+  assert.Nil(t, err)
+  assert.Equal(t, m.Size(), N)
+}
@@ -0,0 +1,161 @@
+package generic
+
+import (
+  "bytes"
+  "encoding/gob"
+  "encoding/json"
+  "fmt"
+  "strconv"
+)
+
+// items Return the items of the tuple. A zero Tuple has no items
+func (tuple *Tuple[T]) items() []T {
+  if tuple.l == nil {
+    return nil
+  }
+  return *tuple.l
+}
+
+// formatItems Write to f the items between parentheses and separated by ", ". Each item is
+// formatted with verb and the flags, width and precision of f, so that %v gives (a, b, c) and
+// %+v, %.2f, %q, ... are applied to every item
+func formatItems(f fmt.State, verb rune, items ...interface{}) {
+
+  format := []byte{'%'}
+  for _, flag := range "+-# 0" {
+    if f.Flag(int(flag)) {
+      format = append(format, byte(flag))
+    }
+  }
+  if width, ok := f.Width(); ok {
+    format = strconv.AppendInt(format, int64(width), 10)
+  }
+  if precision, ok := f.Precision(); ok {
+    format = strconv.AppendInt(append(format, '.'), int64(precision), 10)
+  }
+  format = append(format, string(verb)...)
+
+  f.Write([]byte{'('})
+  for i, item := range items {
+    if i > 0 {
+      f.Write([]byte(", "))
+    }
+    fmt.Fprintf(f, string(format), item)
+  }
+  f.Write([]byte{')'})
+}
+
+// Format Implement fmt.Formatter. The tuple is written as (a, b, c), each item formatted with the
+// verb and flags received
+func (tuple *Tuple[T]) Format(f fmt.State, verb rune) {
+  items := make([]interface{}, 0, len(tuple.items()))
+  for _, item := range tuple.items() {
+    items = append(items, item)
+  }
+  formatItems(f, verb, items...)
+}
+
+// String Return the tuple as (a, b, c)
+func (tuple *Tuple[T]) String() string {
+  return fmt.Sprintf("%v", tuple)
+}
+
+// MarshalText Implement encoding.TextMarshaler. The text is the String of the tuple
+func (tuple *Tuple[T]) MarshalText() ([]byte, error) {
+  return []byte(tuple.String()), nil
+}
+
+// MarshalJSON Implement json.Marshaler. The tuple is encoded as a JSON array
+func (tuple *Tuple[T]) MarshalJSON() ([]byte, error) {
+  if tuple.l == nil {
+    return []byte("[]"), nil
+  }
+  return json.Marshal(*tuple.l)
+}
+
+// UnmarshalJSON Implement json.Unmarshaler. The JSON array is decoded as a []T, so the items get
+// their type T
+func (tuple *Tuple[T]) UnmarshalJSON(data []byte) error {
+  var items []T
+  if err := json.Unmarshal(data, &items); err != nil {
+    return err
+  }
+  if items == nil {
+    items = make([]T, 0)
+  }
+  tuple.l = &items
+  return nil
+}
+
+// GobEncode Implement gob.GobEncoder. Interface items must have their types registered with
+// gob.Register
+func (tuple *Tuple[T]) GobEncode() ([]byte, error) {
+  var buf bytes.Buffer
+  err := gob.NewEncoder(&buf).Encode(tuple.items())
+  return buf.Bytes(), err
+}
+
+// GobDecode Implement gob.GobDecoder
+func (tuple *Tuple[T]) GobDecode(data []byte) error {
+  items := make([]T, 0)
+  if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&items); err != nil {
+    return err
+  }
+  tuple.l = &items
+  return nil
+}
+
+// pairFields The fields of a pair without its methods, so that they can be decoded with the default
+// behavior of encoding/json
+type pairFields[A, B any] struct {
+  Item1 A
+  Item2 B
+}
+
+// Pair is deliberately not an encoding.TextMarshaler nor a gob.GobEncoder: encoding/json and gob
+// encode it as the plain struct it always was, so that the data stored before keeps being readable
+
+// Format Implement fmt.Formatter. The pair is written as (a, b), each item formatted with the verb
+// and flags received
+func (p Pair[A, B]) Format(f fmt.State, verb rune) {
+  formatItems(f, verb, p.Item1, p.Item2)
+}
+
+// String Return the pair as (a, b)
+func (p Pair[A, B]) String() string {
+  return fmt.Sprintf("%v", p)
+}
+
+// UnmarshalJSON Implement json.Unmarshaler. The item Item1 is decoded as an A and Item2 as a B. The
+// array [a, b] is accepted too
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+
+  var fields pairFields[A, B]
+  trimmed := bytes.TrimSpace(data)
+  if string(trimmed) == "null" {
+    return nil
+  }
+  if len(trimmed) > 0 && trimmed[0] == '{' {
+    if err := json.Unmarshal(trimmed, &fields); err != nil {
+      return err
+    }
+    *p = Pair[A, B](fields)
+    return nil
+  }
+
+  var raw []json.RawMessage
+  if err := json.Unmarshal(data, &raw); err != nil {
+    return err
+  }
+  if len(raw) != 2 {
+    return fmt.Errorf("a pair needs 2 items, but %d were found", len(raw))
+  }
+  if err := json.Unmarshal(raw[0], &fields.Item1); err != nil {
+    return err
+  }
+  if err := json.Unmarshal(raw[1], &fields.Item2); err != nil {
+    return err
+  }
+  *p = Pair[A, B](fields)
+  return nil
+}
@@ -0,0 +1,86 @@
+package generic
+
+import (
+  "bytes"
+  "encoding/gob"
+  "encoding/json"
+  "fmt"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestTupleFormat(t *testing.T) {
+
+  assert.Equal(t, NewTuple(1, 2, 3).String(), "(1, 2, 3)")
+  assert.Equal(t, fmt.Sprintf("%03d", NewTuple(7, 42)), "(007, 042)")
+  assert.Equal(t, fmt.Sprintf("%v", Pair[string, int]{Item1: "a", Item2: 1}), "(a, 1)")
+  assert.Equal(t, fmt.Sprintf("%+v", Pair[lineItem, bool]{Item1: lineItem{"o1", 5}, Item2: true}),
+    "({order:o1 price:5}, true)")
+
+  var zero Tuple[int]
+  assert.Equal(t, zero.String(), "()")
+}
+
+func TestTupleJSON(t *testing.T) {
+
+  data, err := json.Marshal(NewTuple(1, 2, 3))
+  assert.NoError(t, err)
+  assert.Equal(t, string(data), "[1,2,3]")
+
+  // the items are decoded with their type
+  var tuple Tuple[int]
+  assert.NoError(t, json.Unmarshal([]byte("[4, 5]"), &tuple))
+  assert.Equal(t, tuple.ToSlice(), []int{4, 5})
+  assert.Error(t, json.Unmarshal([]byte(`["x"]`), &tuple))
+
+  var pairs []Pair[string, *Tuple[float64]]
+  assert.NoError(t, json.Unmarshal([]byte(`[["a", [1.5]], ["b", []]]`), &pairs))
+  assert.Equal(t, pairs[0].Item1, "a")
+  assert.Equal(t, pairs[0].Item2.ToSlice(), []float64{1.5})
+  assert.True(t, pairs[1].Item2.IsEmpty())
+
+  data, err = json.Marshal(pairs)
+  assert.NoError(t, err)
+  assert.Equal(t, string(data), `[{"Item1":"a","Item2":[1.5]},{"Item1":"b","Item2":[]}]`)
+  assert.NoError(t, json.Unmarshal(data, &pairs))
+  assert.Equal(t, pairs[0].Item2.ToSlice(), []float64{1.5})
+
+  var p Pair[int, int]
+  assert.Error(t, json.Unmarshal([]byte(`[1]`), &p))
+  assert.Error(t, json.Unmarshal([]byte(`[1, "x"]`), &p))
+  assert.NoError(t, json.Unmarshal([]byte(`null`), &p))
+}
+
+func TestTupleGob(t *testing.T) {
+
+  var buf bytes.Buffer
+  source := Zip[string, int](NewList("a", "b"), NewList(1, 2)).ToSlice()
+  assert.NoError(t, gob.NewEncoder(&buf).Encode(struct {
+    T *Tuple[string]
+    P []Pair[string, int]
+  }{NewTuple("x", "y"), source}))
+
+  var decoded struct {
+    T *Tuple[string]
+    P []Pair[string, int]
+  }
+  assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+  assert.Equal(t, decoded.T.ToSlice(), []string{"x", "y"})
+  assert.Equal(t, decoded.P, source)
+
+  // a pair is encoded as the plain struct, so it is compatible with the data of a struct without methods
+  type plainPair struct {
+    Item1 string
+    Item2 int
+  }
+  buf.Reset()
+  assert.NoError(t, gob.NewEncoder(&buf).Encode(plainPair{"old", 1}))
+  var p Pair[string, int]
+  assert.NoError(t, gob.NewDecoder(&buf).Decode(&p))
+  assert.Equal(t, p, Pair[string, int]{Item1: "old", Item2: 1})
+
+  assert.NoError(t, gob.NewEncoder(&buf).Encode(Pair[string, int]{Item1: "new", Item2: 2}))
+  var plain plainPair
+  assert.NoError(t, gob.NewDecoder(&buf).Decode(&plain))
+  assert.Equal(t, plain, plainPair{"new", 2})
+}
@@ -0,0 +1,117 @@
+package generic
+
+import (
+  "errors"
+)
+
+// traverseErr Traverse seq executing operation on each item. If all is false, the traversal stops
+// at the first error, which is returned. Otherwise every item is processed and the errors.Join of
+// all the errors is returned
+func traverseErr[T any](seq Sequence[T], all bool, operation func(T) error) error {
+
+  var errs []error
+  seq.Traverse(func(item T) bool {
+    if err := operation(item); err != nil {
+      errs = append(errs, err)
+      return all
+    }
+    return true
+  })
+
+  if !all && len(errs) > 0 {
+    return errs[0]
+  }
+  return errors.Join(errs...)
+}
+
+// ForEachErr Execute operation receiving every item of the sequence. It stops at the first error
+// returned by operation and returns it
+func ForEachErr[T any](seq Sequence[T], operation func(T) error) error {
+  return traverseErr(seq, false, operation)
+}
+
+// ForEachErrAll Execute operation receiving every item of the sequence and return the errors.Join
+// of all the errors returned by operation
+func ForEachErrAll[T any](seq Sequence[T], operation func(T) error) error {
+  return traverseErr(seq, true, operation)
+}
+
+func mapErr[T, U any](seq Sequence[T], all bool, transformation func(T) (U, error)) (*List[U], error) {
+
+  ret := NewList[U]()
+  err := traverseErr(seq, all, func(item T) error {
+    mapped, err := transformation(item)
+    if err != nil {
+      return err
+    }
+    ret.Append(mapped)
+    return nil
+  })
+  return ret, err
+}
+
+// MapErr Return a new list with the items of sequence transformed with transformation. It stops at
+// the first error and returns the items transformed so far with the error
+func MapErr[T, U any](seq Sequence[T], transformation func(T) (U, error)) (*List[U], error) {
+  return mapErr(seq, false, transformation)
+}
+
+// MapErrAll Return a new list with the items of sequence successfully transformed with
+// transformation and the errors.Join of all the errors. The failed items are not in the list
+func MapErrAll[T, U any](seq Sequence[T], transformation func(T) (U, error)) (*List[U], error) {
+  return mapErr(seq, true, transformation)
+}
+
+func filterErr[T any](seq Sequence[T], all bool, predicate func(T) (bool, error)) (*List[T], error) {
+
+  ret := NewList[T]()
+  err := traverseErr(seq, all, func(item T) error {
+    ok, err := predicate(item)
+    if err != nil {
+      return err
+    }
+    if ok {
+      ret.Append(item)
+    }
+    return nil
+  })
+  return ret, err
+}
+
+// FilterErr Return a list containing the items satisfying predicate. It stops at the first error
+// and returns the items selected so far with the error
+func FilterErr[T any](seq Sequence[T], predicate func(T) (bool, error)) (*List[T], error) {
+  return filterErr(seq, false, predicate)
+}
+
+// FilterErrAll Return a list containing the items satisfying predicate and the errors.Join of all
+// the errors. The items for which predicate fails are not in the list
+func FilterErrAll[T any](seq Sequence[T], predicate func(T) (bool, error)) (*List[T], error) {
+  return filterErr(seq, true, predicate)
+}
+
+func foldlErr[T, A any](seq Sequence[T], all bool, initVal A, f func(acu A, item T) (A, error)) (A, error) {
+
+  retVal := initVal
+  err := traverseErr(seq, all, func(item T) error {
+    acu, err := f(retVal, item)
+    if err != nil {
+      return err
+    }
+    retVal = acu
+    return nil
+  })
+  return retVal, err
+}
+
+// FoldlErr Return f(in, ..., f(i2, f(i1, initVal) ... )). It stops at the first error and returns
+// the accumulated value so far with the error
+func FoldlErr[T, A any](seq Sequence[T], initVal A, f func(acu A, item T) (A, error)) (A, error) {
+  return foldlErr(seq, false, initVal, f)
+}
+
+// FoldlErrAll Fold seq as Foldl skipping the items for which f fails. Return the accumulated value
+// and the errors.Join of all the errors
+func FoldlErrAll[T, A any](seq Sequence[T], initVal A, f func(acu A, item T) (A, error)) (A, error) {
+  return foldlErr(seq, true, initVal, f)
+}
@@ -0,0 +1,43 @@
+package generic
+
+import (
+  "errors"
+  "github.com/stretchr/testify/assert"
+  "strconv"
+  "testing"
+)
+
+func TestMapErr(t *testing.T) {
+
+  input := NewTuple("10", "20", "bad", "40")
+
+  m, err := MapErr[string, int](input, strconv.Atoi)
+  var numErr *strconv.NumError
+  assert.True(t, errors.As(err, &numErr))
+  assert.Equal(t, m.ToSlice(), []int{10, 20})
+
+  m, err = MapErrAll[string, int](input, strconv.Atoi)
+  assert.True(t, errors.As(err, &numErr))
+  assert.Equal(t, m.ToSlice(), []int{10, 20, 40})
+}
+
+func TestFoldlFilterErr(t *testing.T) {
+
+  errNeg := errors.New("negative")
+  sum, err := FoldlErr[int, int](NewTuple(1, 2, -3, 4), 0, func(acu, i int) (int, error) {
+    if i < 0 {
+      return acu, errNeg
+    }
+    return acu + i, nil
+  })
+  assert.Equal(t, err, errNeg)
+  assert.Equal(t, sum, 3)
+
+  l, err := FilterErr[int](NewTuple(1, 2, 3), func(i int) (bool, error) { return i != 2, nil })
+  assert.Nil(t, err)
+  assert.Equal(t, l.ToSlice(), []int{1, 3})
+
+  l, err = FilterErrAll[int](NewTuple(1, 2, 3), func(i int) (bool, error) { return true, errNeg })
+  assert.ErrorIs(t, err, errNeg)
+  assert.True(t, l.IsEmpty())
+}
@@ -0,0 +1,49 @@
+package generic
+
+// FlatMap Return a list with the concatenation of the sequences resulting of applying transformation
+// to every item of seq
+func FlatMap[T, U any](seq Sequence[T], transformation func(T) Sequence[U]) *List[U] {
+
+  ret := NewList[U]()
+  ForEach(seq, func(item T) {
+    ForEach(transformation(item), func(i U) {
+      ret.Append(i)
+    })
+  })
+  return ret
+}
+
+// Flatten Return a list with the concatenation of the sequences contained in seq
+func Flatten[T any, S Sequence[T]](seq Sequence[S]) *List[T] {
+  return FlatMap(seq, func(s S) Sequence[T] {
+    return s
+  })
+}
+
+// FlatMapStream Return a lazy stream with the concatenation of the sequences resulting of applying
+// transformation to every item of stream. Each sequence is created when the stream reaches it
+func FlatMapStream[T, U any](stream *Stream[T], transformation func(T) Sequence[U]) *Stream[U] {
+  return &Stream[U]{pull: func(ev *evaluation) func() (U, bool) {
+    next := stream.pull(ev)
+    inner := &evaluation{} // the iterator of the current sequence, stopped when it is left
+    ev.add(inner)
+    nextInner := func() (U, bool) {
+      var zero U
+      return zero, false
+    }
+    return func() (U, bool) {
+      for {
+        if curr, ok := nextInner(); ok {
+          return curr, true
+        }
+        inner.Stop()
+        item, ok := next()
+        if !ok {
+          var zero U
+          return zero, false
+        }
+        nextInner = pullOf(inner, transformation(item))
+      }
+    }
+  }}
+}
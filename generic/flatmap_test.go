@@ -0,0 +1,29 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestFlatMap(t *testing.T) {
+
+  l := FlatMap[int, int](NewTuple(1, 2, 3), func(i int) Sequence[int] {
+    return Take(createSet(), i)
+  })
+  assert.Equal(t, l.ToSlice(), []int{0, 0, 1, 0, 1, 2})
+
+  words := Flatten[string](NewTuple(NewTuple("a", "b"), NewTuple[string](), NewTuple("c")))
+  assert.Equal(t, words.ToSlice(), []string{"a", "b", "c"})
+}
+
+func TestFlatMapStream(t *testing.T) {
+
+  stream := FlatMapStream(NewStream(createSet()), func(i int) Sequence[int] {
+    return NewTuple(i, i)
+  }).Filter(func(i int) bool { return i%2 == 1 }).Take(4)
+
+  assert.Equal(t, stream.ToList().ToSlice(), []int{1, 1, 3, 3})
+  assert.True(t, FlatMapStream(NewStream[int](NewTuple(1, 2)), func(i int) Sequence[int] {
+    return NewTuple[int]()
+  }).IsEmpty())
+}
@@ -0,0 +1,77 @@
+package generic
+
+// traverseReverse Traverse seq from its last item to the first one. It stops if operation returns
+// false. RandomAccess sequences are traversed in place; any other is first copied
+func traverseReverse[T any](seq Sequence[T], operation func(T) bool) bool {
+
+  if ra, ok := seq.(RandomAccess[T]); ok {
+    for i := ra.Size() - 1; i >= 0; i-- {
+      if !operation(ra.Nth(i)) {
+        return false
+      }
+    }
+    return true
+  }
+
+  items := toSlice(seq)
+  for i := len(items) - 1; i >= 0; i-- {
+    if !operation(items[i]) {
+      return false
+    }
+  }
+  return true
+}
+
+// Foldr Return f(i1, f(i2, ... f(in, initVal) ... )). The items are visited from right to left
+func Foldr[T, A any](seq Sequence[T], initVal A, f func(item T, acu A) A) A {
+
+  retVal := initVal
+  traverseReverse(seq, func(item T) bool {
+    retVal = f(item, retVal)
+    return true
+  })
+  return retVal
+}
+
+// Reduce Return f(... f(f(i1, i2), i3) ..., in), that is Foldl using the first item as initial
+// value. It returns None if the sequence is empty
+func Reduce[T any](seq Sequence[T], f func(acu, item T) T) Option[T] {
+
+  ret := None[T]()
+  ForEach(seq, func(item T) {
+    if ret.ok {
+      ret.value = f(ret.value, item)
+    } else {
+      ret = Some(item)
+    }
+  })
+  return ret
+}
+
+// Scanl Return the list of the successive accumulated values of Foldl: initVal, f(initVal, i1),
+// f(f(initVal, i1), i2), ... The last item is Foldl(seq, initVal, f)
+func Scanl[T, A any](seq Sequence[T], initVal A, f func(acu A, item T) A) *List[A] {
+
+  ret := NewList(initVal)
+  acu := initVal
+  ForEach(seq, func(item T) {
+    acu = f(acu, item)
+    ret.Append(acu)
+  })
+  return ret
+}
+
+// Scanr Return the list of the successive accumulated values of Foldr in the order of seq:
+// f(i1, f(i2, ...)), ..., f(in, initVal), initVal. The first item is Foldr(seq, initVal, f)
+func Scanr[T, A any](seq Sequence[T], initVal A, f func(item T, acu A) A) *List[A] {
+
+  ret := NewList[A]()
+  acu := initVal
+  ret.l.Insert(acu)
+  traverseReverse(seq, func(item T) bool {
+    acu = f(item, acu)
+    ret.l.Insert(acu)
+    return true
+  })
+  return ret
+}
@@ -0,0 +1,29 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "strconv"
+  "testing"
+)
+
+func TestFoldr(t *testing.T) {
+
+  show := func(i int, acu string) string { return "(" + strconv.Itoa(i) + " " + acu + ")" }
+
+  assert.Equal(t, Foldr[int, string](NewTuple(1, 2, 3), "z", show), "(1 (2 (3 z)))")
+  assert.Equal(t, Foldr[int, string](NewList(1, 2, 3), "z", show), "(1 (2 (3 z)))")
+  assert.Equal(t, Foldr(createSet(), 0, func(i, acu int) int { return i - acu }), -N/2)
+}
+
+func TestReduceScan(t *testing.T) {
+
+  sum := func(acu, i int) int { return acu + i }
+
+  assert.Equal(t, Reduce(createSet(), sum).MustGet(), N*(N-1)/2)
+  assert.True(t, Reduce[int](NewTuple[int](), sum).IsNone())
+
+  assert.Equal(t, Scanl[int, int](NewTuple(3, 1, 2), 0, sum).ToSlice(), []int{0, 3, 4, 6})
+  assert.Equal(t, Scanr[int, int](NewList(3, 1, 2), 0, func(i, acu int) int {
+    return i + acu
+  }).ToSlice(), []int{6, 3, 2, 0})
+}
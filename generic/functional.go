@@ -0,0 +1,275 @@
+// Package generic Type-parameterized version of the FunctionalLib combinators. The interface{}
+// based functions of FunctionalLib are thin wrappers over the ones defined here
+package generic
+
+// ForEach Execute operation receiving every item of the sequence. Return seq
+func ForEach[T any](seq Sequence[T], operation func(T)) Sequence[T] {
+
+  seq.Traverse(func(i T) bool {
+    operation(i)
+    return true
+  })
+
+  return seq
+}
+
+// All Return true if all the elements of the sequence meets predicate
+func All[T any](seq Sequence[T], predicate func(T) bool) bool {
+  return seq.Traverse(predicate)
+}
+
+// Exist Return true if at least one element of the sequence meets predicate
+func Exist[T any](seq Sequence[T], predicate func(T) bool) bool {
+  return !All(seq, func(i T) bool {
+    return !predicate(i)
+  })
+}
+
+// Map Return a new list with the items of sequence transformed with transformation operation
+func Map[T, U any](seq Sequence[T], transformation func(T) U) *List[U] {
+
+  ret := NewList[U]()
+  ForEach(seq, func(item T) {
+    ret.Append(transformation(item))
+  })
+  return ret
+}
+
+// MapIf Return a new list with the items of sequence transformed with transformation operation for
+// the items satisfying the predicate
+func MapIf[T, U any](seq Sequence[T], transformation func(T) U, predicate func(T) bool) *List[U] {
+
+  ret := NewList[U]()
+  ForEach(seq, func(item T) {
+    if predicate(item) {
+      ret.Append(transformation(item))
+    }
+  })
+  return ret
+}
+
+// Filter Return a list containing the items satisfying predicate
+func Filter[T any](seq Sequence[T], predicate func(T) bool) *List[T] {
+
+  ret := NewList[T]()
+  ForEach(seq, func(item T) {
+    if predicate(item) {
+      ret.Append(item)
+    }
+  })
+  return ret
+}
+
+// Search Return the first item meeting predicate. The second result is false if no item was found
+func Search[T any](seq Sequence[T], predicate func(T) bool) (T, bool) {
+
+  var ret T
+  found := false
+  seq.Traverse(func(item T) bool {
+    if predicate(item) {
+      ret, found = item, true
+      return false
+    }
+    return true
+  })
+
+  return ret, found
+}
+
+// Zip two sequences into one list of pairs. The result is truncated to the shortest sequence
+func Zip[A, B any](s1 Sequence[A], s2 Sequence[B]) *List[Pair[A, B]] {
+
+  ret := NewList[Pair[A, B]]()
+
+  it1, it2 := s1.CreateIterator(), s2.CreateIterator()
+  defer stopIterators(it1, it2)
+  for it1.HasCurr() && it2.HasCurr() {
+
+    ret.Append(Pair[A, B]{
+      Item1: it1.GetCurr(),
+      Item2: it2.GetCurr(),
+    })
+
+    it1.Next()
+    it2.Next()
+  }
+
+  return ret
+}
+
+// Unzip a sequence of pairs into two separated lists
+func Unzip[A, B any](seq Sequence[Pair[A, B]]) (*List[A], *List[B]) {
+
+  l1 := NewList[A]()
+  l2 := NewList[B]()
+
+  ForEach(seq, func(curr Pair[A, B]) {
+    l1.Append(curr.Item1)
+    l2.Append(curr.Item2)
+  })
+
+  return l1, l2
+}
+
+// Split seq into two lists. First contains items satisfying predicate and the second the complement
+func Split[T any](seq Sequence[T], predicate func(item T) bool) (*List[T], *List[T]) {
+
+  l1 := NewList[T]()
+  l2 := NewList[T]()
+
+  ForEach(seq, func(i T) {
+    if predicate(i) {
+      l1.Append(i)
+    } else {
+      l2.Append(i)
+    }
+  })
+
+  return l1, l2
+}
+
+// Find Return the first item in seq satisfying predicate. The second result is false if no item
+// was found
+func Find[T any](seq Sequence[T], predicate func(item T) bool) (T, bool) {
+  return Search(seq, predicate)
+}
+
+// Take Return a list containing the first n items from the sequence
+func Take[T any](seq Sequence[T], n int) *List[T] {
+
+  ret := NewList[T]()
+  if n <= 0 {
+    return ret
+  }
+
+  seq.Traverse(func(item T) bool {
+    ret.Append(item)
+    n--
+    return n > 0
+  })
+
+  return ret
+}
+
+// Drop Return a list containing the items after the first n from the sequence
+func Drop[T any](seq Sequence[T], n int) *List[T] {
+
+  ret := NewList[T]()
+  i := 0
+  ForEach(seq, func(item T) {
+    if i < n {
+      i++
+      return
+    }
+    ret.Append(item)
+  })
+
+  return ret
+}
+
+// TakeWhile Return a list containing the longest prefix of seq whose items satisfy predicate. The
+// traversal stops at the first item not satisfying it
+func TakeWhile[T any](seq Sequence[T], predicate func(item T) bool) *List[T] {
+
+  ret := NewList[T]()
+  seq.Traverse(func(item T) bool {
+    if !predicate(item) {
+      return false
+    }
+    ret.Append(item)
+    return true
+  })
+
+  return ret
+}
+
+// DropWhile Return a list containing the items after the longest prefix of seq whose items satisfy
+// predicate
+func DropWhile[T any](seq Sequence[T], predicate func(item T) bool) *List[T] {
+
+  _, ret := Span(seq, predicate)
+  return ret
+}
+
+// Span seq into two lists in a single traversal. First contains the longest prefix whose items satisfy
+// predicate and the second the remainder of seq. The predicate is not evaluated on the remainder
+func Span[T any](seq Sequence[T], predicate func(item T) bool) (*List[T], *List[T]) {
+
+  l1 := NewList[T]()
+  l2 := NewList[T]()
+
+  inPrefix := true
+  ForEach(seq, func(item T) {
+    if inPrefix && predicate(item) {
+      l1.Append(item)
+      return
+    }
+    inPrefix = false
+    l2.Append(item)
+  })
+
+  return l1, l2
+}
+
+// SplitAt seq into two lists in a single traversal. First contains the first n items and the second
+// the remainder
+func SplitAt[T any](seq Sequence[T], n int) (*List[T], *List[T]) {
+
+  i := 0
+  return Span(seq, func(T) bool {
+    i++
+    return i <= n
+  })
+}
+
+// Foldl Return f(in, ..., f(i2, f(i1, initVal) ... ))
+func Foldl[T, A any](seq Sequence[T], initVal A, f func(acu A, item T) A) A {
+
+  retVal := initVal
+  ForEach(seq, func(i T) {
+    retVal = f(retVal, i)
+  })
+  return retVal
+}
+
+// Nth Return the n-th item in the sequence. The second result is false if n is negative or
+// greater or equal than the size of seq. Only the first n + 1 items are read
+func Nth[T any](seq Sequence[T], n int) (T, bool) {
+
+  var zero T
+  if n < 0 {
+    return zero, false
+  }
+
+  ret := zero
+  found := false
+  seq.Traverse(func(item T) bool {
+    if n == 0 {
+      ret, found = item, true
+      return false
+    }
+    n--
+    return true
+  })
+
+  return ret, found
+}
+
+// Position Return the position in the sequence of the first element satisfying predicate. If no
+// element satisfies predicate then it returns -1
+func Position[T any](seq Sequence[T], predicate func(item T) bool) int {
+
+  pos, found := 0, false
+  seq.Traverse(func(item T) bool {
+    if found = predicate(item); found {
+      return false
+    }
+    pos++
+    return true
+  })
+
+  if !found {
+    return -1
+  }
+  return pos
+}
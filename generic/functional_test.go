@@ -0,0 +1,225 @@
+package generic
+
+import (
+  Seq "github.com/lrleon/Slist"
+  Set "github.com/lrleon/treaps"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func cmpInt(i1, i2 interface{}) bool {
+  return i1.(int) < i2.(int)
+}
+
+const N = 100
+
+func createSet() Sequence[int] {
+
+  tree := Set.New(3, cmpInt)
+  for i := 0; i < N; i++ {
+    tree.Insert(i)
+  }
+
+  return Of[int](tree)
+}
+
+func createList() *List[int] {
+
+  list := NewList[int]()
+  for i := 0; i < N; i++ {
+    list.Append(i)
+  }
+
+  return list
+}
+
+func TestForEach(t *testing.T) {
+
+  i := 0
+  ForEach(createSet(), func(k int) {
+    assert.Equal(t, k, i)
+    i++
+  })
+  assert.Equal(t, i, N)
+}
+
+func TestAllExist(t *testing.T) {
+
+  l := createList()
+
+  assert.True(t, All[int](l, func(i int) bool { return i < N }))
+  assert.False(t, All[int](l, func(i int) bool { return i >= N }))
+  assert.True(t, Exist[int](l, func(i int) bool { return i == N-1 }))
+  assert.False(t, Exist[int](l, func(i int) bool { return i >= N }))
+}
+
+func TestMap(t *testing.T) {
+
+  tree := createSet()
+
+  m := Map(tree, func(i int) string {
+    return string(rune('a' + i%26))
+  })
+
+  assert.Equal(t, m.Size(), N)
+  assert.True(t, All[Pair[int, string]](Zip[int, string](tree, m), func(p Pair[int, string]) bool {
+    return p.Item2 == string(rune('a'+p.Item1%26))
+  }))
+}
+
+func TestMapIf(t *testing.T) {
+
+  pred := func(i int) bool { return i >= 20 && i <= 60 }
+
+  lmap := MapIf(createSet(), func(i int) int { return 2 * i }, pred)
+  assert.Equal(t, lmap.Size(), 41)
+  assert.Equal(t, lmap.First(), 40)
+  assert.Equal(t, lmap.Last(), 120)
+}
+
+func TestFilterSplit(t *testing.T) {
+
+  l50 := Filter(createSet(), func(i int) bool { return i < N/2 })
+  assert.Equal(t, l50.Size(), N/2)
+  assert.True(t, All[int](l50, func(i int) bool { return i < N/2 }))
+
+  l1, l2 := Split[int](createList(), func(i int) bool { return i%2 == 0 })
+  assert.Equal(t, l1.Size(), N/2)
+  assert.Equal(t, l2.Size(), N/2)
+  assert.True(t, All[int](l2, func(i int) bool { return i%2 == 1 }))
+}
+
+func TestZipUnzip(t *testing.T) {
+
+  l1 := createList()
+  l2 := Map[int, string](l1, func(i int) string { return string(rune('a' + i%26)) })
+
+  lzip := Zip[int, string](l1, Take[string](l2, 10))
+  assert.Equal(t, lzip.Size(), 10)
+
+  r1, r2 := Unzip[int, string](lzip)
+  assert.Equal(t, r1.ToSlice(), Take[int](l1, 10).ToSlice())
+  assert.Equal(t, r2.ToSlice(), Take[string](l2, 10).ToSlice())
+}
+
+func TestSearchFindNth(t *testing.T) {
+
+  tree := createSet()
+
+  item, ok := Search(tree, func(i int) bool { return i == N/2 })
+  assert.True(t, ok)
+  assert.Equal(t, item, N/2)
+
+  _, ok = Find(tree, func(i int) bool { return i >= N })
+  assert.False(t, ok)
+
+  _, ok = Nth(tree, -1)
+  assert.False(t, ok)
+  _, ok = Nth(tree, N)
+  assert.False(t, ok)
+  for i := 0; i < N; i++ {
+    item, ok := Nth(tree, i)
+    assert.True(t, ok)
+    assert.Equal(t, item, i)
+  }
+}
+
+func TestTakeDrop(t *testing.T) {
+
+  l10 := Take(createSet(), 10)
+  assert.Equal(t, l10.ToSlice(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+  l90 := Drop(createSet(), 10)
+  assert.Equal(t, l90.Size(), N-10)
+  assert.Equal(t, l90.First(), 10)
+}
+
+func TestTakeWhileDropWhile(t *testing.T) {
+
+  less10 := func(i int) bool { return i < 10 }
+  assert.Equal(t, TakeWhile(createSet(), less10).ToSlice(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+  assert.Equal(t, DropWhile(createSet(), less10).Size(), N-10)
+  assert.Equal(t, DropWhile(createSet(), less10).First(), 10)
+
+  // only the prefix is considered
+  list := NewList(1, 3, 4, 5)
+  odd := func(i int) bool { return i%2 == 1 }
+  assert.Equal(t, TakeWhile[int](list, odd).ToSlice(), []int{1, 3})
+  assert.Equal(t, DropWhile[int](list, odd).ToSlice(), []int{4, 5})
+  assert.True(t, TakeWhile[int](list, func(int) bool { return false }).IsEmpty())
+  assert.True(t, DropWhile[int](list, func(int) bool { return true }).IsEmpty())
+}
+
+func TestTakeWhileStops(t *testing.T) {
+
+  mapped := 0
+  stream := MapStream(NewStream(createSet()), func(i int) int {
+    mapped++
+    return i
+  })
+  assert.Equal(t, TakeWhile[int](stream, func(i int) bool { return i < 2 }).ToSlice(), []int{0, 1})
+  assert.Equal(t, mapped, 3)
+}
+
+func TestSpanSplitAt(t *testing.T) {
+
+  calls := 0
+  prefix, rest := Span[int](NewList(2, 4, 5, 6), func(i int) bool {
+    calls++
+    return i%2 == 0
+  })
+  assert.Equal(t, prefix.ToSlice(), []int{2, 4})
+  assert.Equal(t, rest.ToSlice(), []int{5, 6})
+  assert.Equal(t, calls, 3)
+
+  l1, l2 := SplitAt(createSet(), 30)
+  assert.Equal(t, l1.Size(), 30)
+  assert.Equal(t, l2.Size(), N-30)
+  assert.Equal(t, l2.First(), 30)
+
+  l1, l2 = SplitAt[int](NewList(1, 2), 5)
+  assert.Equal(t, l1.ToSlice(), []int{1, 2})
+  assert.True(t, l2.IsEmpty())
+
+  l1, l2 = SplitAt[int](NewList(1, 2), -1)
+  assert.True(t, l1.IsEmpty())
+  assert.Equal(t, l2.ToSlice(), []int{1, 2})
+}
+
+func TestFoldl(t *testing.T) {
+
+  sum := Foldl(createSet(), 0, func(acu, item int) int { return acu + item })
+  assert.Equal(t, sum, N*(N-1)/2)
+
+  str := Foldl[int, string](Take(createSet(), 3), "", func(acu string, item int) string {
+    return acu + string(rune('0'+item))
+  })
+  assert.Equal(t, str, "012")
+}
+
+func TestPosition(t *testing.T) {
+
+  tree := createSet()
+
+  assert.Equal(t, Position(tree, func(i int) bool { return i == -1 }), -1)
+  for i := 0; i < N; i++ {
+    assert.Equal(t, Position(tree, func(item int) bool { return item == i }), i)
+  }
+}
+
+func TestOfWithNil(t *testing.T) {
+
+  l := Seq.New(1, nil, 3)
+  view := Of[interface{}](l)
+  assert.Equal(t, Position(view, func(i interface{}) bool { return i == nil }), 1)
+  assert.Panics(t, func() {
+    ForEach(Of[string](l), func(string) {})
+  })
+
+  // nil is only the zero value of the types that can hold it
+  assert.Panics(t, func() {
+    Map[int, int](Of[int](l), func(i int) int { return 10 * i })
+  })
+  pointers := Of[*int](Seq.New(nil))
+  assert.Nil(t, pointers.CreateIterator().GetCurr())
+}
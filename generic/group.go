@@ -0,0 +1,59 @@
+package generic
+
+func aggregateInto[T, K, A any](m *OrderedMap[K, A], seq Sequence[T], key func(T) K, initVal A,
+  f func(acu A, item T) A) *OrderedMap[K, A] {
+
+  ForEach(seq, func(item T) {
+    p := m.entry(key(item), initVal)
+    p.Item2 = f(p.Item2, item)
+  })
+  return m
+}
+
+func appendToGroup[T any](group *List[T], item T) *List[T] {
+  if group == nil {
+    return NewList(item)
+  }
+  return group.Append(item)
+}
+
+func increment[T any](count int, _ T) int {
+  return count + 1
+}
+
+// AggregateBy Bucket the items of seq by key and fold each bucket with f starting from initVal.
+// Return a map from every key to the folded value of its bucket, in insertion order of the keys
+func AggregateBy[T any, K comparable, A any](seq Sequence[T], key func(T) K, initVal A,
+  f func(acu A, item T) A) *OrderedMap[K, A] {
+
+  return aggregateInto(NewOrderedMap[K, A](), seq, key, initVal, f)
+}
+
+// AggregateBySorted As AggregateBy but the keys are sorted according to less
+func AggregateBySorted[T, K, A any](seq Sequence[T], key func(T) K, less func(k1, k2 K) bool, initVal A,
+  f func(acu A, item T) A) *OrderedMap[K, A] {
+
+  return aggregateInto(NewSortedMap[K, A](less), seq, key, initVal, f)
+}
+
+// GroupBy Bucket the items of seq by key. Return a map from every key to the list of items having
+// it, in insertion order of the keys. Each list keeps the order of seq
+func GroupBy[T any, K comparable](seq Sequence[T], key func(T) K) *OrderedMap[K, *List[T]] {
+  return AggregateBy(seq, key, nil, appendToGroup[T])
+}
+
+// GroupBySorted As GroupBy but the keys are sorted according to less
+func GroupBySorted[T, K any](seq Sequence[T], key func(T) K, less func(k1, k2 K) bool) *OrderedMap[K, *List[T]] {
+  return AggregateBySorted(seq, key, less, nil, appendToGroup[T])
+}
+
+// CountBy Return a map from every key to the number of items of seq having it, in insertion order of
+// the keys
+func CountBy[T any, K comparable](seq Sequence[T], key func(T) K) *OrderedMap[K, int] {
+  return AggregateBy(seq, key, 0, increment[T])
+}
+
+// CountBySorted As CountBy but the keys are sorted according to less
+func CountBySorted[T, K any](seq Sequence[T], key func(T) K, less func(k1, k2 K) bool) *OrderedMap[K, int] {
+  return AggregateBySorted(seq, key, less, 0, increment[T])
+}
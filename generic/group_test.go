@@ -0,0 +1,51 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+type lineItem struct {
+  order string
+  price int
+}
+
+func lineItems() *Tuple[lineItem] {
+  return NewTuple(lineItem{"o2", 10}, lineItem{"o1", 5}, lineItem{"o2", 7}, lineItem{"o3", 1})
+}
+
+func byOrder(l lineItem) string {
+  return l.order
+}
+
+func TestGroupBy(t *testing.T) {
+
+  groups := GroupBy[lineItem](lineItems(), byOrder)
+  assert.Equal(t, groups.Keys().ToSlice(), []string{"o2", "o1", "o3"})
+  assert.Equal(t, groups.Get("o2").MustGet().ToSlice(), []lineItem{{"o2", 10}, {"o2", 7}})
+  assert.False(t, groups.Has("o4"))
+
+  sorted := GroupBySorted[lineItem](lineItems(), byOrder, func(k1, k2 string) bool { return k1 > k2 })
+  assert.Equal(t, sorted.Keys().ToSlice(), []string{"o3", "o2", "o1"})
+  assert.Equal(t, sorted.Get("o1").MustGet().ToSlice(), []lineItem{{"o1", 5}})
+}
+
+func TestCountAggregateBy(t *testing.T) {
+
+  counts := CountBy(createSet(), func(i int) bool { return i%2 == 0 })
+  assert.Equal(t, counts.Values().ToSlice(), []int{N / 2, N / 2})
+
+  lessStr := func(k1, k2 string) bool { return k1 < k2 }
+  assert.Equal(t, CountBySorted[lineItem](lineItems(), byOrder, lessStr).Values().ToSlice(), []int{1, 2, 1})
+
+  totals := AggregateBySorted[lineItem](lineItems(), byOrder, lessStr, 0, func(acu int, l lineItem) int {
+    return acu + l.price
+  })
+  assert.Equal(t, toSlice[Pair[string, int]](totals), []Pair[string, int]{{"o1", 5}, {"o2", 17}, {"o3", 1}})
+
+  totals = AggregateBy[lineItem](lineItems(), byOrder, 0, func(acu int, l lineItem) int {
+    return acu + l.price
+  })
+  it := totals.CreateIterator()
+  assert.Equal(t, it.GetCurr(), Pair[string, int]{"o2", 17})
+}
@@ -0,0 +1,55 @@
+package generic
+
+import (
+  "container/heap"
+)
+
+// itemHeap A binary heap of items whose top is the minimum according to less. It implements
+// heap.Interface
+type itemHeap[T any] struct {
+  items []T
+  less  func(i1, i2 T) bool
+}
+
+func (h *itemHeap[T]) Len() int {
+  return len(h.items)
+}
+
+func (h *itemHeap[T]) Less(i, j int) bool {
+  return h.less(h.items[i], h.items[j])
+}
+
+func (h *itemHeap[T]) Swap(i, j int) {
+  h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *itemHeap[T]) Push(x any) {
+  h.items = append(h.items, x.(T))
+}
+
+func (h *itemHeap[T]) Pop() any {
+  last := len(h.items) - 1
+  item := h.items[last]
+  var zero T
+  h.items[last] = zero
+  h.items = h.items[:last]
+  return item
+}
+
+func (h *itemHeap[T]) top() T {
+  return h.items[0]
+}
+
+func (h *itemHeap[T]) push(item T) {
+  heap.Push(h, item)
+}
+
+func (h *itemHeap[T]) pop() T {
+  return heap.Pop(h).(T)
+}
+
+// replaceTop Replace the top of the heap by item and restore the heap property
+func (h *itemHeap[T]) replaceTop(item T) {
+  h.items[0] = item
+  heap.Fix(h, 0)
+}
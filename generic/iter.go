@@ -0,0 +1,141 @@
+package generic
+
+import (
+  "iter"
+)
+
+// Values Return an iter.Seq yielding the items of seq, so that it can be used in a range loop
+func Values[T any](seq Sequence[T]) iter.Seq[T] {
+  return func(yield func(T) bool) {
+    seq.Traverse(yield)
+  }
+}
+
+// IteratorValues Return an iter.Seq yielding the items of it. The iterator is reset to its first
+// element each time the returned iter.Seq is ranged
+func IteratorValues[T any](it SequentialIterator[T]) iter.Seq[T] {
+  return func(yield func(T) bool) {
+    for it.ResetFirst(); it.HasCurr(); it.Next() {
+      if !yield(it.GetCurr()) {
+        return
+      }
+    }
+  }
+}
+
+// Enumerate Return an iter.Seq2 yielding the pairs (index, item) of the tuple
+func (tuple *Tuple[T]) Enumerate() iter.Seq2[int, T] {
+  return func(yield func(int, T) bool) {
+    for i, item := range *tuple.l {
+      if !yield(i, item) {
+        return
+      }
+    }
+  }
+}
+
+// IterSequence A Sequence view of an iter.Seq. The items are produced by the iter.Seq each time the
+// sequence is traversed; nothing is copied
+type IterSequence[T any] struct {
+  seq iter.Seq[T]
+}
+
+// SeqIterator Iterator over an IterSequence. It pulls the items from the iter.Seq on demand
+type SeqIterator[T any] struct {
+  seq  iter.Seq[T]
+  next func() (T, bool)
+  stop func()
+  curr T
+  ok   bool
+}
+
+// FromSeq Return a Sequence view of seq, so that it can be consumed by Map, Filter, Zip, etc.
+func FromSeq[T any](seq iter.Seq[T]) *IterSequence[T] {
+  return &IterSequence[T]{seq: seq}
+}
+
+// Traverse the iter.Seq and execute operation on each item. It stops if operation returns false
+func (s *IterSequence[T]) Traverse(operation func(T) bool) bool {
+  for item := range s.seq {
+    if !operation(item) {
+      return false
+    }
+  }
+  return true
+}
+
+// Size Return the number of items produced by the iter.Seq. The iter.Seq is completely ranged
+func (s *IterSequence[T]) Size() int {
+  n := 0
+  for range s.seq {
+    n++
+  }
+  return n
+}
+
+// IsEmpty Return true if the iter.Seq produces no items
+func (s *IterSequence[T]) IsEmpty() bool {
+  for range s.seq {
+    return false
+  }
+  return true
+}
+
+// CreateIterator Return an iterator compliant with the interface Sequence. The iterator pulls the
+// items with iter.Pull, which holds resources until the end of the items is reached. An iterator
+// abandoned before its end must be released with Stop. Traverse and the functions built on it
+// range over the iter.Seq directly and need no release
+func (s *IterSequence[T]) CreateIterator() SequentialIterator[T] {
+  it := &SeqIterator[T]{seq: s.seq}
+  it.ResetFirst()
+  return it
+}
+
+// ResetFirst Restart the iteration from the first item of the iter.Seq
+func (it *SeqIterator[T]) ResetFirst() {
+  it.Stop()
+  it.next, it.stop = iter.Pull(it.seq)
+  it.Next()
+}
+
+// HasCurr Return true if the iterator is on a element
+func (it *SeqIterator[T]) HasCurr() bool {
+  return it.ok
+}
+
+// GetCurr Return the element on which the iterator is positioned
+func (it *SeqIterator[T]) GetCurr() T {
+  return it.curr
+}
+
+// Next Pull the next item of the iter.Seq
+func (it *SeqIterator[T]) Next() {
+  it.curr, it.ok = it.next()
+  if !it.ok {
+    it.Stop()
+  }
+}
+
+// Stop Release the underlying pull iterator. After Stop, HasCurr returns false. It must be called
+// if the iterator is abandoned before its end
+func (it *SeqIterator[T]) Stop() {
+  if it.stop != nil {
+    it.stop()
+    it.stop = nil
+  }
+  it.ok = false
+  it.next = func() (T, bool) {
+    var zero T
+    return zero, false
+  }
+}
+
+// stopIterators Release the iterators holding resources, such as SeqIterator, once an algorithm
+// stops using them
+func stopIterators(its ...interface{}) {
+  for _, it := range its {
+    if s, ok := it.(interface{ Stop() }); ok {
+      s.Stop()
+    }
+  }
+}
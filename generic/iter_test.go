@@ -0,0 +1,78 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "slices"
+  "testing"
+)
+
+func TestValues(t *testing.T) {
+
+  assert.Equal(t, slices.Collect(Values[int](Take(createSet(), 5))), []int{0, 1, 2, 3, 4})
+
+  sum := 0
+  for i := range Values(createSet()) {
+    if i == 10 {
+      break
+    }
+    sum += i
+  }
+  assert.Equal(t, sum, 45)
+
+  it := NewTuple(1, 2, 3).CreateIterator()
+  it.Next()
+  assert.Equal(t, slices.Collect(IteratorValues(it)), []int{1, 2, 3})
+}
+
+func TestTuple_Enumerate(t *testing.T) {
+
+  tuple := NewTuple("a", "b", "c")
+  n := 0
+  for i, s := range tuple.Enumerate() {
+    assert.Equal(t, s, tuple.Nth(i))
+    n++
+  }
+  assert.Equal(t, n, 3)
+}
+
+func TestFromSeq(t *testing.T) {
+
+  seq := FromSeq(slices.Values([]int{5, 6, 7, 8}))
+
+  assert.Equal(t, seq.Size(), 4)
+  assert.Equal(t, Map[int, int](seq, func(i int) int { return -i }).ToSlice(), []int{-5, -6, -7, -8})
+
+  item, ok := Find[int](seq, func(i int) bool { return i > 6 })
+  assert.True(t, ok)
+  assert.Equal(t, item, 7)
+
+  zl := Zip[int, string](seq, FromSeq(slices.Values([]string{"x", "y"})))
+  assert.Equal(t, zl.ToSlice(), []Pair[int, string]{{5, "x"}, {6, "y"}})
+
+  it := seq.CreateIterator().(*SeqIterator[int])
+  it.Next()
+  assert.Equal(t, it.GetCurr(), 6)
+  it.ResetFirst()
+  assert.Equal(t, it.GetCurr(), 5)
+  it.Stop()
+  assert.False(t, it.HasCurr())
+}
+
+func TestFromSeqRelease(t *testing.T) {
+
+  running := 0
+  naturals := FromSeq(func(yield func(int) bool) {
+    running++
+    defer func() { running-- }()
+    for i := 0; yield(i); i++ {
+    }
+  })
+
+  // the functions stopping early release the iter.Seq before returning
+  item, _ := Search[int](naturals, func(i int) bool { return i == 3 })
+  assert.Equal(t, item, 3)
+  assert.Equal(t, Take[int](naturals, 2).ToSlice(), []int{0, 1})
+  assert.Equal(t, Position[int](naturals, func(i int) bool { return i == 4 }), 4)
+  assert.Equal(t, Zip[int, int](naturals, NewTuple(7, 8)).Size(), 2)
+  assert.Equal(t, running, 0)
+}
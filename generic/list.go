@@ -0,0 +1,105 @@
+package generic
+
+import (
+  Seq "github.com/lrleon/Slist"
+)
+
+// List A typed facade over a Seq.Slist. Every item stored in the underlying list has type T
+type List[T any] struct {
+  l *Seq.Slist
+}
+
+// ListIterator Iterator over a List
+type ListIterator[T any] struct {
+  it *Seq.Iterator
+}
+
+// NewList Return a new list with the received elements
+func NewList[T any](items ...T) *List[T] {
+  list := &List[T]{l: Seq.New()}
+  for _, item := range items {
+    list.l.Append(item)
+  }
+  return list
+}
+
+// ListOf Return a typed view of l. The caller guarantees that every item of l has type T
+func ListOf[T any](l *Seq.Slist) *List[T] {
+  return &List[T]{l: l}
+}
+
+// Slist Return the underlying interface{} based list. No copy is done
+func (list *List[T]) Slist() *Seq.Slist {
+  return list.l
+}
+
+// Append one or more elements to the list
+func (list *List[T]) Append(item T, items ...T) *List[T] {
+  list.l.Append(item)
+  for _, i := range items {
+    list.l.Append(i)
+  }
+  return list
+}
+
+// Traverse the list and execute operation on each element. It stops if operation returns false
+func (list *List[T]) Traverse(operation func(T) bool) bool {
+  return list.l.Traverse(func(item interface{}) bool {
+    return operation(cast[T](item))
+  })
+}
+
+// Size Return the number of elements of the list
+func (list *List[T]) Size() int {
+  return list.l.Size()
+}
+
+// IsEmpty Return true if the list is empty
+func (list *List[T]) IsEmpty() bool {
+  return list.l.IsEmpty()
+}
+
+// First Return the first element of the list. The list must not be empty
+func (list *List[T]) First() T {
+  return cast[T](list.l.First())
+}
+
+// Last Return the last element of the list. The list must not be empty
+func (list *List[T]) Last() T {
+  return cast[T](list.l.Last())
+}
+
+// ToSlice Return a slice with the elements of the list
+func (list *List[T]) ToSlice() []T {
+  ret := make([]T, 0, 4)
+  list.Traverse(func(item T) bool {
+    ret = append(ret, item)
+    return true
+  })
+  return ret
+}
+
+// CreateIterator Return an iterator to the list compliant with the interface Sequence
+func (list *List[T]) CreateIterator() SequentialIterator[T] {
+  return &ListIterator[T]{it: Seq.NewIterator(list.l)}
+}
+
+// ResetFirst Reset the iterator to the first element
+func (it *ListIterator[T]) ResetFirst() {
+  it.it.ResetFirst()
+}
+
+// HasCurr Return true if the iterator is on a element
+func (it *ListIterator[T]) HasCurr() bool {
+  return it.it.HasCurr()
+}
+
+// GetCurr Return the element on which the iterator is positioned
+func (it *ListIterator[T]) GetCurr() T {
+  return cast[T](it.it.GetCurr())
+}
+
+// Next Advance the iterator to the next item of the list
+func (it *ListIterator[T]) Next() {
+  it.it.Next()
+}
@@ -0,0 +1,94 @@
+package generic
+
+// Merge Return a list with the items of the sorted sequences s1 and s2 sorted according to less. The
+// merge is stable: of two equivalent items, the one of s1 goes first
+func Merge[T any](s1, s2 Sequence[T], less func(i1, i2 T) bool) *List[T] {
+
+  ret := NewList[T]()
+  it1, it2 := s1.CreateIterator(), s2.CreateIterator()
+  defer stopIterators(it1, it2)
+  for it1.HasCurr() && it2.HasCurr() {
+    if less(it2.GetCurr(), it1.GetCurr()) {
+      ret.Append(it2.GetCurr())
+      it2.Next()
+    } else {
+      ret.Append(it1.GetCurr())
+      it1.Next()
+    }
+  }
+
+  for ; it1.HasCurr(); it1.Next() {
+    ret.Append(it1.GetCurr())
+  }
+  for ; it2.HasCurr(); it2.Next() {
+    ret.Append(it2.GetCurr())
+  }
+
+  return ret
+}
+
+// mergeCursor The current item of one of the sequences merged by KWayMerge
+type mergeCursor[T any] struct {
+  item T
+  next func() (T, bool)
+  src  int // position of the sequence in the merge, used for breaking ties
+}
+
+// KWayMerge Return a lazy stream with the items of the sorted sequences seqs sorted according to
+// less. A heap holds the current item of every sequence, so each item takes O(log k) time and the
+// sequences are only read as the stream is evaluated. The merge is stable: equivalent items keep
+// the order of seqs
+func KWayMerge[T any](less func(i1, i2 T) bool, seqs ...Sequence[T]) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+
+    h := &itemHeap[mergeCursor[T]]{less: func(c1, c2 mergeCursor[T]) bool {
+      return less(c1.item, c2.item) || (!less(c2.item, c1.item) && c1.src < c2.src)
+    }}
+    for i, seq := range seqs {
+      next := pullOf(ev, seq)
+      if item, ok := next(); ok {
+        h.push(mergeCursor[T]{item: item, next: next, src: i})
+      }
+    }
+
+    return func() (T, bool) {
+      if h.Len() == 0 {
+        var zero T
+        return zero, false
+      }
+
+      cursor := h.top()
+      ret := cursor.item
+      if item, ok := cursor.next(); ok {
+        cursor.item = item
+        h.replaceTop(cursor)
+      } else {
+        h.pop()
+      }
+      return ret, true
+    }
+  }}
+}
+
+// runs Split seq into its maximal sorted runs according to less
+func runs[T any](seq Sequence[T], less func(i1, i2 T) bool) []Sequence[T] {
+
+  ret := make([]Sequence[T], 0)
+  var run *List[T]
+  var last T
+  ForEach(seq, func(item T) {
+    if run == nil || less(item, last) {
+      run = NewList[T]()
+      ret = append(ret, run)
+    }
+    run.Append(item)
+    last = item
+  })
+  return ret
+}
+
+// MergeSort Return a list with the items of seq stably sorted according to less. The sorted runs of
+// seq are merged with KWayMerge, so an almost sorted sequence with r runs is sorted in O(n log r)
+func MergeSort[T any](seq Sequence[T], less func(i1, i2 T) bool) *List[T] {
+  return KWayMerge(less, runs(seq, less)...).ToList()
+}
@@ -0,0 +1,49 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "math/rand"
+  "testing"
+)
+
+func byPrice(l1, l2 lineItem) bool {
+  return l1.price < l2.price
+}
+
+func TestMergeStable(t *testing.T) {
+
+  s1 := NewList(lineItem{"a", 1}, lineItem{"a", 5})
+  s2 := NewList(lineItem{"b", 1}, lineItem{"b", 3}, lineItem{"b", 5})
+  assert.Equal(t, Merge[lineItem](s1, s2, byPrice).ToSlice(), []lineItem{
+    {"a", 1}, {"b", 1}, {"b", 3}, {"a", 5}, {"b", 5}})
+}
+
+func TestKWayMerge(t *testing.T) {
+
+  s1 := NewList(lineItem{"a", 2}, lineItem{"a", 4})
+  s2 := NewList(lineItem{"b", 1}, lineItem{"b", 4})
+  s3 := NewList(lineItem{"c", 2})
+  assert.Equal(t, KWayMerge[lineItem](byPrice, s1, s2, s3).ToList().ToSlice(), []lineItem{
+    {"b", 1}, {"a", 2}, {"c", 2}, {"a", 4}, {"b", 4}})
+
+  // the sequences are read lazily
+  read := 0
+  counted := MapStream(NewStream(createSet()), func(i int) int {
+    read++
+    return i
+  })
+  first, _ := KWayMerge[int](Less[int], counted, NewList(-1)).Find(func(int) bool { return true })
+  assert.Equal(t, first, -1)
+  assert.LessOrEqual(t, read, 2) // the head of counted plus the lookahead of its iterator
+}
+
+func TestMergeSort(t *testing.T) {
+
+  items := NewTuple(rand.Perm(N)...)
+  assert.Equal(t, MergeSort[int](items, Less[int]).ToSlice(), toSlice(createSet()))
+  assert.Equal(t, MergeSort[int](NewList(1, 2, 3), Less[int]).ToSlice(), []int{1, 2, 3})
+
+  // stable
+  sorted := MergeSort[lineItem](lineItems(), func(l1, l2 lineItem) bool { return l1.order < l2.order })
+  assert.Equal(t, sorted.ToSlice(), []lineItem{{"o1", 5}, {"o2", 10}, {"o2", 7}, {"o3", 1}})
+}
@@ -0,0 +1,215 @@
+package generic
+
+import (
+  "fmt"
+)
+
+// Option An optional value: either Some(value) or None. Unlike a nil interface{}, it distinguishes
+// an absent value from a present zero or nil value
+type Option[T any] struct {
+  value T
+  ok    bool
+}
+
+// Some Return an Option containing value
+func Some[T any](value T) Option[T] {
+  return Option[T]{value: value, ok: true}
+}
+
+// None Return an empty Option
+func None[T any]() Option[T] {
+  return Option[T]{}
+}
+
+// OptionOf Return Some(value) if ok is true, None otherwise. Useful with the comma-ok idiom
+func OptionOf[T any](value T, ok bool) Option[T] {
+  if !ok {
+    return None[T]()
+  }
+  return Some(value)
+}
+
+// IsSome Return true if the option contains a value
+func (o Option[T]) IsSome() bool {
+  return o.ok
+}
+
+// IsNone Return true if the option is empty
+func (o Option[T]) IsNone() bool {
+  return !o.ok
+}
+
+// Get Return the contained value and true, or the zero value and false if the option is empty
+func (o Option[T]) Get() (T, bool) {
+  return o.value, o.ok
+}
+
+// MustGet Return the contained value. It panics if the option is empty
+func (o Option[T]) MustGet() T {
+  if !o.ok {
+    panic("MustGet called on an empty Option")
+  }
+  return o.value
+}
+
+// OrElse Return the contained value or other if the option is empty
+func (o Option[T]) OrElse(other T) T {
+  if !o.ok {
+    return other
+  }
+  return o.value
+}
+
+// OrElseGet Return the contained value or the result of f if the option is empty. f is only called
+// if the option is empty
+func (o Option[T]) OrElseGet(f func() T) T {
+  if !o.ok {
+    return f()
+  }
+  return o.value
+}
+
+// Filter Return the option if it contains a value satisfying predicate, None otherwise
+func (o Option[T]) Filter(predicate func(T) bool) Option[T] {
+  if o.ok && predicate(o.value) {
+    return o
+  }
+  return None[T]()
+}
+
+// String Return "Some(value)" or "None"
+func (o Option[T]) String() string {
+  if !o.ok {
+    return "None"
+  }
+  return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// MapOption Return Some(transformation(value)) if o contains a value, None otherwise
+func MapOption[T, U any](o Option[T], transformation func(T) U) Option[U] {
+  if !o.ok {
+    return None[U]()
+  }
+  return Some(transformation(o.value))
+}
+
+// FlatMapOption Return transformation(value) if o contains a value, None otherwise
+func FlatMapOption[T, U any](o Option[T], transformation func(T) Option[U]) Option[U] {
+  if !o.ok {
+    return None[U]()
+  }
+  return transformation(o.value)
+}
+
+// Result The outcome of a fallible operation: either a value or an error
+type Result[T any] struct {
+  value T
+  err   error
+}
+
+// Ok Return a successful Result containing value
+func Ok[T any](value T) Result[T] {
+  return Result[T]{value: value}
+}
+
+// Err Return a failed Result containing err
+func Err[T any](err error) Result[T] {
+  return Result[T]{err: err}
+}
+
+// ResultOf Return a Result from the pair (value, err) returned by a fallible function
+func ResultOf[T any](value T, err error) Result[T] {
+  if err != nil {
+    return Err[T](err)
+  }
+  return Ok(value)
+}
+
+// IsOk Return true if the result is successful
+func (r Result[T]) IsOk() bool {
+  return r.err == nil
+}
+
+// IsErr Return true if the result is failed
+func (r Result[T]) IsErr() bool {
+  return r.err != nil
+}
+
+// Get Return the contained value and error
+func (r Result[T]) Get() (T, error) {
+  return r.value, r.err
+}
+
+// Error Return the contained error; nil if the result is successful
+func (r Result[T]) Error() error {
+  return r.err
+}
+
+// MustGet Return the contained value. It panics if the result is failed
+func (r Result[T]) MustGet() T {
+  if r.err != nil {
+    panic(fmt.Sprintf("MustGet called on a failed Result: %v", r.err))
+  }
+  return r.value
+}
+
+// OrElse Return the contained value or other if the result is failed
+func (r Result[T]) OrElse(other T) T {
+  if r.err != nil {
+    return other
+  }
+  return r.value
+}
+
+// ToOption Return Some(value) if the result is successful, None otherwise
+func (r Result[T]) ToOption() Option[T] {
+  return OptionOf(r.value, r.err == nil)
+}
+
+// String Return "Ok(value)" or "Err(error)"
+func (r Result[T]) String() string {
+  if r.err != nil {
+    return fmt.Sprintf("Err(%v)", r.err)
+  }
+  return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// MapResult Return Ok(transformation(value)) if r is successful, otherwise a failed result with the
+// same error
+func MapResult[T, U any](r Result[T], transformation func(T) U) Result[U] {
+  if r.err != nil {
+    return Err[U](r.err)
+  }
+  return Ok(transformation(r.value))
+}
+
+// FlatMapResult Return transformation(value) if r is successful, otherwise a failed result with the
+// same error
+func FlatMapResult[T, U any](r Result[T], transformation func(T) Result[U]) Result[U] {
+  if r.err != nil {
+    return Err[U](r.err)
+  }
+  return transformation(r.value)
+}
+
+// SearchOpt Return Some with the first item meeting predicate, None if no item meets it
+func SearchOpt[T any](seq Sequence[T], predicate func(T) bool) Option[T] {
+  return OptionOf(Search(seq, predicate))
+}
+
+// FindOpt Return Some with the first item satisfying predicate, None if no item satisfies it
+func FindOpt[T any](seq Sequence[T], predicate func(T) bool) Option[T] {
+  return OptionOf(Find(seq, predicate))
+}
+
+// NthOpt Return Some with the n-th item of the sequence, None if n is out of range
+func NthOpt[T any](seq Sequence[T], n int) Option[T] {
+  return OptionOf(Nth(seq, n))
+}
+
+// PositionOpt Return Some with the position of the first item satisfying predicate, None if no item
+// satisfies it
+func PositionOpt[T any](seq Sequence[T], predicate func(T) bool) Option[int] {
+  pos := Position(seq, predicate)
+  return OptionOf(pos, pos >= 0)
+}
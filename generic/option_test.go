@@ -0,0 +1,61 @@
+package generic
+
+import (
+  "errors"
+  "github.com/stretchr/testify/assert"
+  "strconv"
+  "testing"
+)
+
+func TestOption(t *testing.T) {
+
+  some := Some(21)
+  assert.True(t, some.IsSome())
+  assert.Equal(t, MapOption(some, func(i int) int { return 2 * i }).MustGet(), 42)
+  assert.Equal(t, MapOption(some, strconv.Itoa).OrElse(""), "21")
+  assert.True(t, some.Filter(func(i int) bool { return i > 30 }).IsNone())
+
+  half := func(i int) Option[int] { return OptionOf(i/2, i%2 == 0) }
+  assert.True(t, FlatMapOption(some, half).IsNone())
+  assert.Equal(t, FlatMapOption(Some(8), half).MustGet(), 4)
+  assert.True(t, FlatMapOption(None[int](), half).IsNone())
+
+  calls := 0
+  assert.Equal(t, None[int]().OrElseGet(func() int { calls++; return 7 }), 7)
+  assert.Equal(t, some.OrElseGet(func() int { calls++; return 7 }), 21)
+  assert.Equal(t, calls, 1)
+  assert.Equal(t, some.String(), "Some(21)")
+}
+
+func TestResult(t *testing.T) {
+
+  r := ResultOf(strconv.Atoi("12"))
+  assert.True(t, r.IsOk())
+  assert.Equal(t, MapResult(r, func(i int) int { return i + 1 }).MustGet(), 13)
+  assert.Equal(t, r.String(), "Ok(12)")
+
+  bad := ResultOf(strconv.Atoi("x"))
+  assert.True(t, bad.IsErr())
+  var numErr *strconv.NumError
+  assert.True(t, errors.As(bad.Error(), &numErr))
+  assert.True(t, MapResult(bad, strconv.Itoa).IsErr())
+  assert.Equal(t, bad.OrElse(-1), -1)
+  assert.Panics(t, func() { bad.MustGet() })
+
+  parsed := FlatMapResult(Ok("34"), func(s string) Result[int] { return ResultOf(strconv.Atoi(s)) })
+  v, err := parsed.Get()
+  assert.Nil(t, err)
+  assert.Equal(t, v, 34)
+}
+
+func TestOptVariants(t *testing.T) {
+
+  tree := createSet()
+
+  assert.Equal(t, SearchOpt(tree, func(i int) bool { return i > 10 }).MustGet(), 11)
+  assert.True(t, FindOpt(tree, func(i int) bool { return i < 0 }).IsNone())
+  assert.Equal(t, NthOpt(tree, 0).MustGet(), 0)
+  assert.True(t, NthOpt(tree, N).IsNone())
+  assert.Equal(t, PositionOpt(tree, func(i int) bool { return i == 50 }).MustGet(), 50)
+  assert.True(t, PositionOpt(tree, func(i int) bool { return i == N }).IsNone())
+}
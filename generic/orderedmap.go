@@ -0,0 +1,125 @@
+package generic
+
+import (
+  Set "github.com/lrleon/treaps"
+)
+
+// OrderedMap A map whose entries are traversed in a deterministic order: the insertion order of the
+// keys (NewOrderedMap) or the order given by a comparator on the keys (NewSortedMap). It implements
+// Sequence of the Pair (key, value), so that it can be consumed by every combinator
+type OrderedMap[K, V any] struct {
+  index map[interface{}]*Pair[K, V] // insertion ordered maps only
+  order *Tuple[*Pair[K, V]]         // insertion ordered maps only
+  tree  *Set.Treap                  // sorted maps only
+}
+
+// NewOrderedMap Return an empty map whose entries are traversed in insertion order of their keys
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+  return &OrderedMap[K, V]{
+    index: make(map[interface{}]*Pair[K, V]),
+    order: NewTuple[*Pair[K, V]](),
+  }
+}
+
+// NewSortedMap Return an empty map whose entries are traversed in the order of their keys defined by
+// less. Keys k1 and k2 are considered equal if neither less(k1, k2) nor less(k2, k1)
+func NewSortedMap[K, V any](less func(k1, k2 K) bool) *OrderedMap[K, V] {
+  return &OrderedMap[K, V]{
+    tree: Set.NewTreap(func(i1, i2 interface{}) bool {
+      return less(i1.(*Pair[K, V]).Item1, i2.(*Pair[K, V]).Item1)
+    }),
+  }
+}
+
+// search Return the entry of key or nil if key is not in the map
+func (m *OrderedMap[K, V]) search(key K) *Pair[K, V] {
+  if m.tree != nil {
+    if found := m.tree.Search(&Pair[K, V]{Item1: key}); found != nil {
+      return found.(*Pair[K, V])
+    }
+    return nil
+  }
+  return m.index[key]
+}
+
+// entry Return the entry of key. If key is not in the map, then it is inserted with value initVal
+func (m *OrderedMap[K, V]) entry(key K, initVal V) *Pair[K, V] {
+
+  if m.tree != nil {
+    _, found := m.tree.SearchOrInsert(&Pair[K, V]{Item1: key, Item2: initVal})
+    return found.(*Pair[K, V])
+  }
+
+  p, ok := m.index[key]
+  if !ok {
+    p = &Pair[K, V]{Item1: key, Item2: initVal}
+    m.index[key] = p
+    m.order.Append(p)
+  }
+  return p
+}
+
+// Put Associate value to key. If key was already in the map, then its position in the order is kept
+func (m *OrderedMap[K, V]) Put(key K, value V) *OrderedMap[K, V] {
+  m.entry(key, value).Item2 = value
+  return m
+}
+
+// Get Return Some with the value associated to key, None if key is not in the map
+func (m *OrderedMap[K, V]) Get(key K) Option[V] {
+  if p := m.search(key); p != nil {
+    return Some(p.Item2)
+  }
+  return None[V]()
+}
+
+// Has Return true if key is in the map
+func (m *OrderedMap[K, V]) Has(key K) bool {
+  return m.search(key) != nil
+}
+
+// entries Return the sequence of entries in order
+func (m *OrderedMap[K, V]) entries() Sequence[*Pair[K, V]] {
+  if m.tree != nil {
+    return Of[*Pair[K, V]](m.tree)
+  }
+  return m.order
+}
+
+// Traverse the entries in order and execute operation on each one. It stops if operation returns false
+func (m *OrderedMap[K, V]) Traverse(operation func(Pair[K, V]) bool) bool {
+  return m.entries().Traverse(func(p *Pair[K, V]) bool {
+    return operation(*p)
+  })
+}
+
+// Size Return the number of keys of the map
+func (m *OrderedMap[K, V]) Size() int {
+  return m.entries().Size()
+}
+
+// IsEmpty Return true if the map has no keys
+func (m *OrderedMap[K, V]) IsEmpty() bool {
+  return m.entries().IsEmpty()
+}
+
+// CreateIterator Return an iterator to the entries compliant with the interface Sequence
+func (m *OrderedMap[K, V]) CreateIterator() SequentialIterator[Pair[K, V]] {
+  return MapStream(NewStream(m.entries()), func(p *Pair[K, V]) Pair[K, V] {
+    return *p
+  }).CreateIterator()
+}
+
+// Keys Return the list of keys in order
+func (m *OrderedMap[K, V]) Keys() *List[K] {
+  return Map(m.entries(), func(p *Pair[K, V]) K {
+    return p.Item1
+  })
+}
+
+// Values Return the list of values in the order of their keys
+func (m *OrderedMap[K, V]) Values() *List[V] {
+  return Map(m.entries(), func(p *Pair[K, V]) V {
+    return p.Item2
+  })
+}
@@ -0,0 +1,126 @@
+package generic
+
+import (
+  "runtime"
+  "sync"
+  "sync/atomic"
+)
+
+// toSlice Return a slice with the items of seq
+func toSlice[T any](seq Sequence[T]) []T {
+  ret := make([]T, 0, sizeHint(seq))
+  seq.Traverse(func(item T) bool {
+    ret = append(ret, item)
+    return true
+  })
+  return ret
+}
+
+// numWorkers Return the number of workers to use. A non-positive value means GOMAXPROCS and no more
+// workers than items are used
+func numWorkers(workers, n int) int {
+  if workers <= 0 {
+    workers = runtime.GOMAXPROCS(0)
+  }
+  if workers > n {
+    workers = n
+  }
+  return workers
+}
+
+// parallelFor Execute body(i) for i in [0, n) distributing the indexes among workers goroutines.
+// It returns when all the calls have finished. If a call panics, the pending indexes are skipped and
+// the first panic is raised again in the calling goroutine
+func parallelFor(n, workers int, body func(i int)) {
+
+  var wg sync.WaitGroup
+  var nextIdx atomic.Int64
+  var once sync.Once
+  var failure interface{}
+  failed := false
+  workers = numWorkers(workers, n)
+  wg.Add(workers)
+  for w := 0; w < workers; w++ {
+    go func() {
+      defer wg.Done()
+      defer func() {
+        if r := recover(); r != nil {
+          once.Do(func() { failure, failed = r, true })
+          nextIdx.Store(int64(n))
+        }
+      }()
+      for i := int(nextIdx.Add(1) - 1); i < n; i = int(nextIdx.Add(1) - 1) {
+        body(i)
+      }
+    }()
+  }
+  wg.Wait()
+  if failed {
+    panic(failure)
+  }
+}
+
+// ParallelMap Return a new list with the items of sequence transformed with transformation. The
+// transformations are executed by a pool of workers goroutines (GOMAXPROCS if workers <= 0), but the
+// result preserves the order of seq. transformation must be safe for concurrent use. A panic in a
+// worker is raised again in the calling goroutine
+func ParallelMap[T, U any](seq Sequence[T], workers int, transformation func(T) U) *List[U] {
+
+  items := toSlice(seq)
+  results := make([]U, len(items))
+  parallelFor(len(items), workers, func(i int) {
+    results[i] = transformation(items[i])
+  })
+
+  return NewList(results...)
+}
+
+// ParallelFilter Return a list containing the items satisfying predicate in the same order of seq.
+// The predicate is evaluated by a pool of workers goroutines (GOMAXPROCS if workers <= 0) and must be
+// safe for concurrent use
+func ParallelFilter[T any](seq Sequence[T], workers int, predicate func(T) bool) *List[T] {
+
+  items := toSlice(seq)
+  selected := make([]bool, len(items))
+  parallelFor(len(items), workers, func(i int) {
+    selected[i] = predicate(items[i])
+  })
+
+  ret := NewList[T]()
+  for i, item := range items {
+    if selected[i] {
+      ret.Append(item)
+    }
+  }
+  return ret
+}
+
+// ParallelFoldl Split seq into workers contiguous chunks (GOMAXPROCS if workers <= 0), fold each
+// chunk concurrently with f starting from initVal and merge the partial results from left to right
+// with combine. The result equals Foldl(seq, initVal, f) when combine is associative, initVal is its
+// identity and f(acu, item) == combine(acu, f(initVal, item))
+func ParallelFoldl[T, A any](seq Sequence[T], workers int, initVal A,
+  f func(acu A, item T) A, combine func(A, A) A) A {
+
+  items := toSlice(seq)
+  if len(items) == 0 {
+    return initVal
+  }
+
+  workers = numWorkers(workers, len(items))
+  partials := make([]A, workers)
+  chunk := (len(items) + workers - 1) / workers
+  parallelFor(workers, workers, func(w int) {
+    acu := initVal
+    for i := w * chunk; i < len(items) && i < (w+1)*chunk; i++ {
+      acu = f(acu, items[i])
+    }
+    partials[w] = acu
+  })
+
+  retVal := partials[0]
+  for _, partial := range partials[1:] {
+    retVal = combine(retVal, partial)
+  }
+  return retVal
+}
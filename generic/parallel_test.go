@@ -0,0 +1,53 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "strconv"
+  "sync/atomic"
+  "testing"
+)
+
+func TestParallelMap(t *testing.T) {
+
+  var calls atomic.Int32
+  m := ParallelMap(createSet(), 4, func(i int) string {
+    calls.Add(1)
+    return strconv.Itoa(i)
+  })
+
+  assert.Equal(t, int(calls.Load()), N)
+  assert.Equal(t, m.ToSlice(), Map(createSet(), strconv.Itoa).ToSlice())
+  assert.True(t, ParallelMap(NewTuple[int](), 4, strconv.Itoa).IsEmpty())
+}
+
+func TestParallelMapPanic(t *testing.T) {
+
+  // the panic of a worker is raised again in the caller, where it can be recovered
+  assert.PanicsWithValue(t, "bad item", func() {
+    ParallelMap(createSet(), 4, func(i int) int {
+      if i == N/2 {
+        panic("bad item")
+      }
+      return i
+    })
+  })
+}
+
+func TestParallelFilter(t *testing.T) {
+
+  even := func(i int) bool { return i%2 == 0 }
+  assert.Equal(t, ParallelFilter(createSet(), 0, even).ToSlice(), Filter(createSet(), even).ToSlice())
+}
+
+func TestParallelFoldl(t *testing.T) {
+
+  digits := ParallelFoldl(createSet(), 6, "", func(acu string, i int) string {
+    return acu + strconv.Itoa(i%10)
+  }, func(s1, s2 string) string {
+    return s1 + s2
+  })
+
+  assert.Equal(t, digits, Foldl(createSet(), "", func(acu string, i int) string {
+    return acu + strconv.Itoa(i%10)
+  }))
+}
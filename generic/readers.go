@@ -0,0 +1,251 @@
+package generic
+
+import (
+  "bufio"
+  "bytes"
+  "encoding/csv"
+  "fmt"
+  "io"
+  "slices"
+)
+
+// ReaderSeq A lazy Sequence over the items decoded from an io.Reader. It is also its own iterator:
+// the items are read one at a time as they are requested, so the reader is consumed only once. If
+// the reader is an io.Seeker, ResetFirst seeks back to its start and the items are read again;
+// otherwise ResetFirst does nothing and the iteration continues from the current item. Traverse,
+// Size and IsEmpty start at the current item and Size consumes the reader.
+//
+// The iteration ends at the end of the reader or at the first reading or decoding error, which is
+// then available through Err. A ReaderSeq is not safe for concurrent use
+type ReaderSeq[T any] struct {
+  r        io.Reader
+  open     func(r io.Reader) func() (T, error)
+  next     func() (T, error)
+  extra    []T // appended items, yielded after the ones of the reader
+  extraPos int
+  curr     T
+  ok       bool
+  pending  bool // the current item has not been read yet
+  eof      bool
+  err      error
+}
+
+// NewReaderSeq Return a lazy sequence over the items read from r. open receives r and returns the
+// function reading the next item, which must return io.EOF when there are no more items. open is
+// called again each time the sequence is reset on a seekable reader
+func NewReaderSeq[T any](r io.Reader, open func(r io.Reader) func() (T, error)) *ReaderSeq[T] {
+  seq := &ReaderSeq[T]{r: r, open: open}
+  seq.restart()
+  return seq
+}
+
+func (seq *ReaderSeq[T]) restart() {
+  seq.next = seq.open(seq.r)
+  seq.extraPos = 0
+  seq.pending = true
+  seq.eof = false
+  seq.err = nil
+}
+
+// fetch Read the current item if it has not been read yet
+func (seq *ReaderSeq[T]) fetch() {
+
+  if !seq.pending {
+    return
+  }
+  seq.pending = false
+
+  if !seq.eof {
+    item, err := seq.next()
+    if err == nil {
+      seq.curr, seq.ok = item, true
+      return
+    }
+    seq.eof = true
+    if err != io.EOF {
+      seq.ok, seq.err = false, err
+      return
+    }
+  }
+
+  if seq.ok = seq.extraPos < len(seq.extra); seq.ok {
+    seq.curr = seq.extra[seq.extraPos]
+    seq.extraPos++
+  }
+}
+
+// Err Return the first error found while reading or decoding, or nil if the iteration ended at the
+// end of the reader
+func (seq *ReaderSeq[T]) Err() error {
+  return seq.err
+}
+
+// Append one or more items to be yielded after the ones of the reader
+func (seq *ReaderSeq[T]) Append(item T, items ...T) *ReaderSeq[T] {
+  seq.extra = append(append(seq.extra, item), items...)
+  return seq
+}
+
+// ResetFirst Restart the iteration from the first item if the reader is an io.Seeker
+func (seq *ReaderSeq[T]) ResetFirst() {
+  seeker, ok := seq.r.(io.Seeker)
+  if !ok {
+    return
+  }
+  seq.restart()
+  if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+    seq.eof, seq.err = true, err
+  }
+}
+
+// HasCurr Return true if the iterator is on an item. The item is read if it was not yet
+func (seq *ReaderSeq[T]) HasCurr() bool {
+  seq.fetch()
+  return seq.ok
+}
+
+// GetCurr Return the current item
+func (seq *ReaderSeq[T]) GetCurr() T {
+  seq.fetch()
+  return seq.curr
+}
+
+// Next Advance to the next item. It is read when it is requested
+func (seq *ReaderSeq[T]) Next() {
+  seq.fetch()
+  seq.pending = seq.ok
+}
+
+// Traverse the remaining items and execute operation on each one. It stops if operation returns false;
+// the item on which it stops is consumed, but the next one is not read yet
+func (seq *ReaderSeq[T]) Traverse(operation func(T) bool) bool {
+  for ; seq.HasCurr(); seq.Next() {
+    if !operation(seq.GetCurr()) {
+      seq.Next()
+      return false
+    }
+  }
+  return true
+}
+
+// Size Read all the remaining items and return how many they are
+func (seq *ReaderSeq[T]) Size() int {
+  n := 0
+  for ; seq.HasCurr(); seq.Next() {
+    n++
+  }
+  return n
+}
+
+// IsEmpty Return true if there are no remaining items
+func (seq *ReaderSeq[T]) IsEmpty() bool {
+  return !seq.HasCurr()
+}
+
+// CreateIterator Return the sequence itself, which is its own iterator
+func (seq *ReaderSeq[T]) CreateIterator() SequentialIterator[T] {
+  return seq
+}
+
+// MapReader Return a lazy sequence over the items of seq transformed with transformation. It reads
+// from the reader of seq, so seq must not have been iterated and must not be used anymore. The items
+// appended to seq are not kept
+func MapReader[T, U any](seq *ReaderSeq[T], transformation func(T) U) *ReaderSeq[U] {
+  return NewReaderSeq(seq.r, func(r io.Reader) func() (U, error) {
+    next := seq.open(r)
+    return func() (U, error) {
+      item, err := next()
+      if err != nil {
+        var zero U
+        return zero, err
+      }
+      return transformation(item), nil
+    }
+  })
+}
+
+// ScanOf Return a lazy sequence over the tokens of r delimited by split, which has the semantics of
+// bufio.Scanner. Tokens of up to maxTokenSize bytes are accepted; a non-positive value means
+// bufio.MaxScanTokenSize
+func ScanOf(r io.Reader, split bufio.SplitFunc, maxTokenSize int) *ReaderSeq[string] {
+
+  if maxTokenSize <= 0 {
+    maxTokenSize = bufio.MaxScanTokenSize
+  }
+
+  return NewReaderSeq(r, func(r io.Reader) func() (string, error) {
+    scanner := bufio.NewScanner(r)
+    scanner.Buffer(nil, maxTokenSize)
+    scanner.Split(split)
+    return func() (string, error) {
+      if scanner.Scan() {
+        return scanner.Text(), nil
+      }
+      if err := scanner.Err(); err != nil {
+        return "", err
+      }
+      return "", io.EOF
+    }
+  })
+}
+
+// LinesOf Return a lazy sequence over the lines of r without their end of line
+func LinesOf(r io.Reader) *ReaderSeq[string] {
+  return ScanOf(r, bufio.ScanLines, 0)
+}
+
+// CSVRecords Return a lazy sequence over the records of the CSV data of r. Each record is a tuple
+// with its fields. The default options of csv.Reader are used; see CSVRecordsWith for setting them
+func CSVRecords(r io.Reader) *ReaderSeq[*Tuple[string]] {
+  return CSVRecordsWith(r, nil)
+}
+
+// CSVRecordsWith Return a lazy sequence over the records of the CSV data of r as CSVRecords does.
+// configure, if not nil, is called on every csv.Reader created, so that its options (Comma,
+// Comment, FieldsPerRecord, ...) can be set
+func CSVRecordsWith(r io.Reader, configure func(*csv.Reader)) *ReaderSeq[*Tuple[string]] {
+  return NewReaderSeq(r, func(r io.Reader) func() (*Tuple[string], error) {
+    records := csv.NewReader(r)
+    if configure != nil {
+      configure(records)
+    }
+    return func() (*Tuple[string], error) {
+      record, err := records.Read()
+      if err != nil {
+        return nil, err
+      }
+      record = slices.Clone(record) // configure may have set ReuseRecord
+      return TupleOf(&record), nil
+    }
+  })
+}
+
+// JSONLinesOf Return a lazy sequence over the items decoded with decode from every non blank line
+// of r, as in the JSON Lines format. A decoding error stops the iteration and is reported by Err
+// together with its line number
+func JSONLinesOf[T any](r io.Reader, decode func(line []byte) (T, error)) *ReaderSeq[T] {
+  return NewReaderSeq(r, func(r io.Reader) func() (T, error) {
+    scanner := bufio.NewScanner(r)
+    scanner.Buffer(nil, 64*bufio.MaxScanTokenSize)
+    lineNumber := 0
+    return func() (T, error) {
+      var zero T
+      for scanner.Scan() {
+        lineNumber++
+        line := bytes.TrimSpace(scanner.Bytes())
+        if len(line) == 0 {
+          continue
+        }
+        item, err := decode(line)
+        if err != nil {
+          return zero, fmt.Errorf("line %d: %w", lineNumber, err)
+        }
+        return item, nil
+      }
+      if err := scanner.Err(); err != nil {
+        return zero, err
+      }
+      return zero, io.EOF
+    }
+  })
+}
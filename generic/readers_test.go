@@ -0,0 +1,152 @@
+package generic
+
+import (
+  "bufio"
+  "encoding/csv"
+  "encoding/json"
+  "errors"
+  "github.com/stretchr/testify/assert"
+  "io"
+  "strconv"
+  "strings"
+  "testing"
+  "testing/iotest"
+)
+
+// noSeek Hide the Seek method of the wrapped reader
+type noSeek struct {
+  io.Reader
+}
+
+func TestLinesOf(t *testing.T) {
+
+  text := "10\n20\n\n30\n"
+  lines := LinesOf(strings.NewReader(text))
+  assert.Equal(t, ToSlice[string](lines), []string{"10", "20", "", "30"})
+  assert.NoError(t, lines.Err())
+
+  // a seekable reader can be traversed again
+  lines.ResetFirst()
+  numbers := Filter[string](lines, func(l string) bool { return l != "" })
+  assert.Equal(t, Foldl(numbers, 0, func(acu int, l string) int {
+    n, _ := strconv.Atoi(l)
+    return acu + n
+  }), 60)
+
+  // otherwise the items are read only once
+  once := LinesOf(noSeek{strings.NewReader(text)})
+  assert.Equal(t, Take[string](once, 2).ToSlice(), []string{"10", "20"})
+  once.ResetFirst()
+  assert.Equal(t, once.Size(), 2)
+  assert.True(t, once.IsEmpty())
+
+  words := ScanOf(strings.NewReader("a bb  ccc"), bufio.ScanWords, 0).Append("dddd")
+  assert.Equal(t, Map[string, int](words, func(w string) int { return len(w) }).ToSlice(), []int{1, 2, 3, 4})
+}
+
+func TestLinesOfError(t *testing.T) {
+
+  failure := errors.New("disk failure")
+  r := io.MultiReader(strings.NewReader("one\ntwo\n"), iotest.ErrReader(failure))
+  lines := LinesOf(r)
+  assert.Equal(t, ToSlice[string](lines), []string{"one", "two"})
+  assert.ErrorIs(t, lines.Err(), failure)
+
+  tooLong := ScanOf(strings.NewReader(strings.Repeat("x", 100)), bufio.ScanLines, 10)
+  assert.True(t, tooLong.IsEmpty())
+  assert.Error(t, tooLong.Err())
+}
+
+func TestCSVRecords(t *testing.T) {
+
+  data := "name;qty\nbolt;10\n\"nut;big\";5\n"
+  records := CSVRecordsWith(strings.NewReader(data), func(r *csv.Reader) { r.Comma = ';' })
+  header := records.GetCurr()
+  assert.Equal(t, header.ToSlice(), []string{"name", "qty"})
+  records.Next()
+
+  rows := Map[*Tuple[string], string](records, func(row *Tuple[string]) string { return row.Nth(0) })
+  assert.Equal(t, rows.ToSlice(), []string{"bolt", "nut;big"})
+  assert.NoError(t, records.Err())
+
+  // the records are copied, so they are not overwritten when the csv.Reader reuses its slice
+  reused := CSVRecordsWith(strings.NewReader("a,b\nc,d\n"), func(r *csv.Reader) { r.ReuseRecord = true })
+  assert.Equal(t, Map[*Tuple[string], string](SliceOf(ToSlice[*Tuple[string]](reused)),
+    (*Tuple[string]).String).ToSlice(), []string{"(a, b)", "(c, d)"})
+
+  bad := CSVRecords(strings.NewReader("a,b\nc\n"))
+  assert.Equal(t, bad.Size(), 1)
+  assert.Error(t, bad.Err())
+}
+
+type event struct {
+  Kind  string `json:"kind"`
+  Value int    `json:"value"`
+}
+
+func decodeEvent(line []byte) (event, error) {
+  var e event
+  err := json.Unmarshal(line, &e)
+  return e, err
+}
+
+func TestJSONLinesOf(t *testing.T) {
+
+  data := `{"kind": "a", "value": 1}
+
+{"kind": "b", "value": 2}
+{"kind": "a", "value": 3}
+`
+  events := JSONLinesOf(strings.NewReader(data), decodeEvent)
+  counts := CountBy[event](events, func(e event) string { return e.Kind })
+  assert.Equal(t, counts.Get("a").MustGet(), 2)
+  assert.Equal(t, counts.Get("b").MustGet(), 1)
+  assert.NoError(t, events.Err())
+
+  broken := JSONLinesOf(strings.NewReader("{\"kind\": \"a\"}\n\n{oops\n{}\n"), decodeEvent)
+  assert.Equal(t, broken.Size(), 1)
+  assert.Contains(t, broken.Err().Error(), "line 3")
+
+  values := MapReader(JSONLinesOf(strings.NewReader(data), decodeEvent), func(e event) int { return e.Value })
+  assert.Equal(t, ToSlice[int](values), []int{1, 2, 3})
+}
+
+func TestNthLazy(t *testing.T) {
+
+  lines := LinesOf(noSeek{strings.NewReader("a\nb\nc\n")})
+  assert.Equal(t, NthOpt[string](lines, 1).MustGet(), "b")
+  assert.Equal(t, ToSlice[string](lines), []string{"c"})
+  assert.True(t, NthOpt[string](LinesOf(strings.NewReader("a\n")), 1).IsNone())
+
+  generated := 0
+  perms := MapStream(Permutations[int](NewTuple(1, 2, 3, 4, 5, 6)), func(p *Tuple[int]) *Tuple[int] {
+    generated++
+    return p
+  })
+  assert.Equal(t, NthOpt[*Tuple[int]](perms, 0).MustGet().ToSlice(), []int{1, 2, 3, 4, 5, 6})
+  assert.LessOrEqual(t, generated, 2) // the first permutation plus the lookahead of the iterator
+}
+
+func TestTakeLazy(t *testing.T) {
+
+  ch := make(chan int, 2)
+  ch <- 1
+  ch <- 2
+  assert.Equal(t, Take[int](ChanOf(ch), 2).ToSlice(), []int{1, 2}) // blocks if a third item is received
+
+  read := 0
+  counted := NewReaderSeq(strings.NewReader("a\nb\nc\nd\n"), func(r io.Reader) func() (string, error) {
+    lines := LinesOf(r)
+    return func() (string, error) {
+      if !lines.HasCurr() {
+        return "", io.EOF
+      }
+      read++
+      line := lines.GetCurr()
+      lines.Next()
+      return line, nil
+    }
+  })
+  assert.Equal(t, Take[string](counted, 2).ToSlice(), []string{"a", "b"})
+  assert.Equal(t, read, 2)
+}
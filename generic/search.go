@@ -0,0 +1,55 @@
+package generic
+
+import (
+  "slices"
+  "sort"
+)
+
+// LowerBound Return the first position of the tuple, sorted according to less, whose item is not less
+// than item. It returns Size() if every item is less than item. Complexity O(log n)
+func (tuple *Tuple[T]) LowerBound(item T, less func(i1, i2 T) bool) int {
+  s := *tuple.l
+  return sort.Search(len(s), func(i int) bool {
+    return !less(s[i], item)
+  })
+}
+
+// UpperBound Return the first position of the tuple, sorted according to less, whose item is greater
+// than item. It returns Size() if no item is greater than item. Complexity O(log n)
+func (tuple *Tuple[T]) UpperBound(item T, less func(i1, i2 T) bool) int {
+  s := *tuple.l
+  return sort.Search(len(s), func(i int) bool {
+    return less(item, s[i])
+  })
+}
+
+// EqualRange Return the interval [first, last) of positions of the tuple, sorted according to less,
+// whose items are equal to item. The interval is empty if item is not in the tuple
+func (tuple *Tuple[T]) EqualRange(item T, less func(i1, i2 T) bool) (first, last int) {
+  return tuple.LowerBound(item, less), tuple.UpperBound(item, less)
+}
+
+// BinarySearch Search item in the tuple sorted according to less. Return the position of the first
+// item equal to item and true, or the position where item would be inserted and false
+func (tuple *Tuple[T]) BinarySearch(item T, less func(i1, i2 T) bool) (int, bool) {
+  pos := tuple.LowerBound(item, less)
+  return pos, pos < tuple.Size() && !less(item, tuple.Nth(pos))
+}
+
+// InsertSorted Insert item in the tuple sorted according to less keeping it sorted. The item is
+// placed after the items equal to it
+func (tuple *Tuple[T]) InsertSorted(item T, less func(i1, i2 T) bool) *Tuple[T] {
+  *tuple.l = slices.Insert(*tuple.l, tuple.UpperBound(item, less), item)
+  return tuple
+}
+
+// InsertSortedUnique Insert item in the tuple sorted according to less only if no item equal to it
+// is already present, so that the tuple can be used as a sorted set. Return true if item was inserted
+func (tuple *Tuple[T]) InsertSortedUnique(item T, less func(i1, i2 T) bool) bool {
+  pos, found := tuple.BinarySearch(item, less)
+  if found {
+    return false
+  }
+  *tuple.l = slices.Insert(*tuple.l, pos, item)
+  return true
+}
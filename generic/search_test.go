@@ -0,0 +1,25 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestTuple_BinarySearchFamily(t *testing.T) {
+
+  words := NewTuple("apple", "kiwi", "kiwi", "pear")
+
+  first, last := words.EqualRange("kiwi", Less[string])
+  assert.Equal(t, []int{first, last}, []int{1, 3})
+
+  pos, found := words.BinarySearch("fig", Less[string])
+  assert.False(t, found)
+  assert.Equal(t, pos, 1)
+
+  words.InsertSorted("fig", Less[string]).InsertSorted("zucchini", Less[string])
+  assert.Equal(t, words.ToSlice(), []string{"apple", "fig", "kiwi", "kiwi", "pear", "zucchini"})
+
+  assert.False(t, words.InsertSortedUnique("pear", Less[string]))
+  assert.True(t, words.InsertSortedUnique("banana", Less[string]))
+  assert.Equal(t, words.LowerBound("banana", Less[string]), 1)
+}
@@ -0,0 +1,130 @@
+package generic
+
+import (
+  "fmt"
+)
+
+// partition Three-way partition of s[lo..hi] around the median of s[lo], s[mid] and s[hi]. On return
+// s[lo..lt-1] < pivot, s[lt..gt] == pivot and s[gt+1..hi] > pivot
+func partition[T any](s []T, lo, hi int, less func(i1, i2 T) bool) (lt, gt int) {
+
+  mid := lo + (hi-lo)/2
+  a, b, c := s[lo], s[mid], s[hi]
+  if less(b, a) {
+    a, b = b, a
+  }
+  if less(c, b) {
+    b = c
+    if less(b, a) {
+      b = a
+    }
+  }
+  pivot := b // median of three
+
+  lt, i, gt := lo, lo, hi
+  for i <= gt {
+    switch {
+    case less(s[i], pivot):
+      s[lt], s[i] = s[i], s[lt]
+      lt++
+      i++
+    case less(pivot, s[i]):
+      s[i], s[gt] = s[gt], s[i]
+      gt--
+    default:
+      i++
+    }
+  }
+  return lt, gt
+}
+
+// NthElementInterval Rearrange in place the subsequence in [i, j] so that the item at position n is the
+// one that would be there if [i, j] were sorted according to less, the items in [i, n) are not
+// greater than it and the items in (n, j] are not less than it. Expected time is O(j - i)
+func (tuple *Tuple[T]) NthElementInterval(i, j, n int, less func(i1, i2 T) bool) *Tuple[T] {
+
+  tuple.validateInterval(i, j)
+  if n < i || n > j {
+    panic(fmt.Sprintf("n = %d is not in [%d, %d]", n, i, j))
+  }
+
+  s := *tuple.l
+  for lo, hi := i, j; lo < hi; {
+    lt, gt := partition(s, lo, hi, less)
+    switch {
+    case n < lt:
+      hi = lt - 1
+    case n > gt:
+      lo = gt + 1
+    default:
+      return tuple
+    }
+  }
+  return tuple
+}
+
+// NthElement Rearrange in place the tuple so that the item at position n is the one that would be
+// there if the tuple were sorted. It panics if n is not a position of the tuple, which is always the
+// case for an empty tuple. See NthElementInterval
+func (tuple *Tuple[T]) NthElement(n int, less func(i1, i2 T) bool) *Tuple[T] {
+  if n < 0 || n >= tuple.Size() {
+    panic(fmt.Sprintf("Invalid value for n = %d", n))
+  }
+  return tuple.NthElementInterval(0, tuple.Size()-1, n, less)
+}
+
+// PartialSortInterval Rearrange in place the subsequence in [i, j] so that its k smallest items
+// according to less are sorted in [i, i + k). The order of the rest of items is unspecified. Nothing
+// is done if k is zero, even on an empty tuple
+func (tuple *Tuple[T]) PartialSortInterval(i, j, k int, less func(i1, i2 T) bool) *Tuple[T] {
+
+  if k < 0 {
+    panic(fmt.Sprintf("Invalid value for k = %d", k))
+  }
+
+  if k == 0 {
+    return tuple
+  }
+
+  tuple.validateInterval(i, j)
+  if k > j-i+1 {
+    panic(fmt.Sprintf("k = %d greater than interval size = %d", k, j-i+1))
+  }
+
+  tuple.NthElementInterval(i, j, i+k-1, less)
+  prefix := (*tuple.l)[i : i+k]
+  TupleOf(&prefix).SortInPlace(less)
+  return tuple
+}
+
+// PartialSort Rearrange in place the tuple so that its k smallest items are sorted at its beginning.
+// See PartialSortInterval
+func (tuple *Tuple[T]) PartialSort(k int, less func(i1, i2 T) bool) *Tuple[T] {
+  return tuple.PartialSortInterval(0, tuple.Size()-1, k, less)
+}
+
+// TopK Return a sorted list with the k smallest items of seq according to less. Pass a greater
+// comparator to get the k greatest ones. It uses a heap bounded to k items, so it takes
+// O(n log k) time and O(k) space
+func TopK[T any](seq Sequence[T], k int, less func(i1, i2 T) bool) *List[T] {
+
+  if k <= 0 {
+    return NewList[T]()
+  }
+
+  // max-heap according to less: its top is the greatest of the k smallest items seen so far
+  h := &itemHeap[T]{less: func(i1, i2 T) bool { return less(i2, i1) }}
+  ForEach(seq, func(item T) {
+    if h.Len() < k {
+      h.push(item)
+    } else if less(item, h.top()) {
+      h.replaceTop(item)
+    }
+  })
+
+  items := make([]T, h.Len())
+  for i := len(items) - 1; i >= 0; i-- {
+    items[i] = h.pop()
+  }
+  return NewList(items...)
+}
@@ -0,0 +1,47 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "math/rand"
+  "testing"
+)
+
+func TestTuple_NthElementRandom(t *testing.T) {
+
+  rng := rand.New(rand.NewSource(3))
+  for trial := 0; trial < 50; trial++ {
+    n := 1 + rng.Intn(60)
+    items := make([]int, n)
+    for i := range items {
+      items[i] = rng.Intn(10) // plenty of duplicates
+    }
+    sorted := NewTuple(items...).Sort(Less[int])
+
+    k := rng.Intn(n)
+    tuple := NewTuple(items...).NthElement(k, Less[int])
+    assert.Equal(t, tuple.Nth(k), sorted.Nth(k))
+
+    partial := NewTuple(items...).PartialSort(k, Less[int])
+    assert.Equal(t, partial.ToSlice()[:k], sorted.ToSlice()[:k])
+  }
+}
+
+func TestTuple_SelectionEmpty(t *testing.T) {
+
+  empty := NewTuple[int]()
+  assert.True(t, empty.PartialSort(0, Less[int]).IsEmpty())
+  assert.Panics(t, func() { empty.NthElement(0, Less[int]) })
+  assert.Panics(t, func() { empty.PartialSort(1, Less[int]) })
+
+  // n and k are validated regardless of the size of the tuple
+  assert.PanicsWithValue(t, "Invalid value for n = 3", func() { NewTuple(1, 2, 3).NthElement(3, Less[int]) })
+  assert.PanicsWithValue(t, "Invalid value for k = -1", func() { NewTuple(1, 2).PartialSort(-1, Less[int]) })
+  assert.PanicsWithValue(t, "Invalid value for k = -1", func() { empty.PartialSort(-1, Less[int]) })
+}
+
+func TestTopK(t *testing.T) {
+
+  greater := func(i1, i2 int) bool { return i1 > i2 }
+  assert.Equal(t, TopK(createSet(), 4, greater).ToSlice(), []int{99, 98, 97, 96})
+  assert.Equal(t, TopK[int](NewTuple(5, 1, 5, 2), 3, Less[int]).ToSlice(), []int{1, 2, 5})
+}
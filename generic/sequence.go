@@ -0,0 +1,147 @@
+package generic
+
+import (
+  "reflect"
+)
+
+// SequentialIterator Type-safe counterpart of the FunctionalLib.SequentialIterator interface
+type SequentialIterator[T any] interface {
+  ResetFirst()
+  HasCurr() bool
+  GetCurr() T
+  Next()
+}
+
+// Sequence Type-safe counterpart of the FunctionalLib.Sequence interface. Only the read
+// operations required by the combinators are demanded
+type Sequence[T any] interface {
+  Traverse(func(T) bool) bool
+  Size() int
+  IsEmpty() bool
+  CreateIterator() SequentialIterator[T]
+}
+
+// RandomAccess Sequences offering access by position in O(1), such as Tuple. Some algorithms (Foldr,
+// Scanr, ...) take advantage of it when the sequence implements it
+type RandomAccess[T any] interface {
+  Size() int
+  Nth(i int) T
+}
+
+// sizeHint Return the size of seq if it is known without traversing it, zero otherwise. Size would
+// consume a single pass sequence
+func sizeHint[T any](seq Sequence[T]) int {
+  if ra, ok := seq.(RandomAccess[T]); ok {
+    return ra.Size()
+  }
+  return 0
+}
+
+// Pair A pair of typed items. Returned by Zip
+type Pair[A, B any] struct {
+  Item1 A
+  Item2 B
+}
+
+// DynamicIterator Method set of the interface{} based iterators (Slist, Treap and Tuple iterators)
+type DynamicIterator interface {
+  ResetFirst() interface{}
+  HasCurr() bool
+  GetCurr() interface{}
+  Next() interface{}
+}
+
+// Dynamic Method set of the interface{} based sequences (Slist, Treap and Tuple) needed by Of
+type Dynamic interface {
+  Traverse(func(interface{}) bool) bool
+  Size() int
+  IsEmpty() bool
+  CreateIterator() interface{}
+}
+
+// cast Convert item to T. A nil item is converted to the zero value of T if T can hold nil, so that
+// interface{} sequences containing nil may be safely viewed as Sequence[interface{}] or Sequence[*X].
+// Otherwise it panics, as the type assertion of any other item of a wrong type does
+func cast[T any](item interface{}) T {
+  if item == nil && nillable(reflect.TypeFor[T]()) {
+    var zero T
+    return zero
+  }
+  return item.(T)
+}
+
+// nillable Return true if the values of typ can be nil
+func nillable(typ reflect.Type) bool {
+  switch typ.Kind() {
+  case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func,
+    reflect.UnsafePointer:
+    return true
+  }
+  return false
+}
+
+type dynamicSequence[T any] struct {
+  seq Dynamic
+}
+
+type dynamicRandomAccess[T any] struct {
+  dynamicSequence[T]
+  ra interface{ Nth(int) interface{} }
+}
+
+type dynamicIterator[T any] struct {
+  it DynamicIterator
+}
+
+// Of Return a view of the interface{} based sequence seq as a Sequence[T]. The items are not
+// copied; each one is asserted to T when it is read, so a wrong type panics at that point. If seq
+// provides Nth(int), as Tuple does, then the view also implements RandomAccess[T]
+func Of[T any](seq Dynamic) Sequence[T] {
+  if ra, ok := seq.(interface{ Nth(int) interface{} }); ok {
+    return &dynamicRandomAccess[T]{dynamicSequence: dynamicSequence[T]{seq: seq}, ra: ra}
+  }
+  return &dynamicSequence[T]{seq: seq}
+}
+
+func (s *dynamicSequence[T]) Traverse(operation func(T) bool) bool {
+  return s.seq.Traverse(func(item interface{}) bool {
+    return operation(cast[T](item))
+  })
+}
+
+func (s *dynamicSequence[T]) Size() int {
+  return s.seq.Size()
+}
+
+func (s *dynamicSequence[T]) IsEmpty() bool {
+  return s.seq.IsEmpty()
+}
+
+func (s *dynamicSequence[T]) CreateIterator() SequentialIterator[T] {
+  return &dynamicIterator[T]{it: s.seq.CreateIterator().(DynamicIterator)}
+}
+
+func (s *dynamicRandomAccess[T]) Nth(i int) T {
+  return cast[T](s.ra.Nth(i))
+}
+
+func (it *dynamicIterator[T]) ResetFirst() {
+  it.it.ResetFirst()
+}
+
+func (it *dynamicIterator[T]) HasCurr() bool {
+  return it.it.HasCurr()
+}
+
+func (it *dynamicIterator[T]) GetCurr() T {
+  return cast[T](it.it.GetCurr())
+}
+
+func (it *dynamicIterator[T]) Next() {
+  it.it.Next()
+}
+
+// Stop Release the underlying iterator if it holds resources
+func (it *dynamicIterator[T]) Stop() {
+  stopIterators(it.it)
+}
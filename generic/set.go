@@ -0,0 +1,174 @@
+package generic
+
+// DistinctBy Return a list with the items of seq whose key has not been seen before, so that only the
+// first occurrence of every key is kept. The order of seq is preserved
+func DistinctBy[T any, K comparable](seq Sequence[T], key func(T) K) *List[T] {
+
+  seen := make(map[K]struct{})
+  return Filter(seq, func(item T) bool {
+    k := key(item)
+    if _, ok := seen[k]; ok {
+      return false
+    }
+    seen[k] = struct{}{}
+    return true
+  })
+}
+
+// Distinct Return a list with the first occurrence of every item of seq, in the order of seq
+func Distinct[T comparable](seq Sequence[T]) *List[T] {
+  return DistinctBy(seq, func(item T) T {
+    return item
+  })
+}
+
+// setOf Return the set of items of seq
+func setOf[T comparable](seq Sequence[T]) map[T]struct{} {
+  ret := make(map[T]struct{}, sizeHint(seq))
+  ForEach(seq, func(item T) {
+    ret[item] = struct{}{}
+  })
+  return ret
+}
+
+// distinctIf Append to ret the first occurrence of every item of seq satisfying predicate
+func distinctIf[T comparable](ret *List[T], seen map[T]struct{}, seq Sequence[T],
+  predicate func(T) bool) *List[T] {
+
+  ForEach(seq, func(item T) {
+    if _, ok := seen[item]; !ok && predicate(item) {
+      seen[item] = struct{}{}
+      ret.Append(item)
+    }
+  })
+  return ret
+}
+
+func in[T comparable](set map[T]struct{}) func(T) bool {
+  return func(item T) bool {
+    _, ok := set[item]
+    return ok
+  }
+}
+
+func notIn[T comparable](set map[T]struct{}) func(T) bool {
+  return func(item T) bool {
+    _, ok := set[item]
+    return !ok
+  }
+}
+
+func always[T any](T) bool {
+  return true
+}
+
+// Union Return a list with the distinct items of s1 followed by the distinct items of s2 not in s1.
+// The order of the sequences is preserved
+func Union[T comparable](s1, s2 Sequence[T]) *List[T] {
+  seen := make(map[T]struct{})
+  return distinctIf(distinctIf(NewList[T](), seen, s1, always[T]), seen, s2, always[T])
+}
+
+// Intersect Return a list with the distinct items of s1 that are also in s2, in the order of s1
+func Intersect[T comparable](s1, s2 Sequence[T]) *List[T] {
+  return distinctIf(NewList[T](), make(map[T]struct{}), s1, in(setOf(s2)))
+}
+
+// Difference Return a list with the distinct items of s1 that are not in s2, in the order of s1
+func Difference[T comparable](s1, s2 Sequence[T]) *List[T] {
+  return distinctIf(NewList[T](), make(map[T]struct{}), s1, notIn(setOf(s2)))
+}
+
+// SymmetricDifference Return a list with the distinct items of s1 not in s2 followed by the distinct
+// items of s2 not in s1. Each sequence is traversed once, so single pass sequences can be used
+func SymmetricDifference[T comparable](s1, s2 Sequence[T]) *List[T] {
+  items1, items2 := NewTuple(toSlice(s1)...), NewTuple(toSlice(s2)...)
+  seen := make(map[T]struct{})
+  ret := distinctIf(NewList[T](), seen, items1, notIn(setOf[T](items2)))
+  return distinctIf(ret, seen, items2, notIn(setOf[T](items1)))
+}
+
+// sortedSet Return the items of seq sorted according to less and without equivalent items. Two items
+// are equivalent if neither is less than the other
+func sortedSet[T any](seq Sequence[T], less func(i1, i2 T) bool) []T {
+
+  items := *NewTuple(toSlice(seq)...).StableSortInPlace(less).l
+  if len(items) == 0 {
+    return items
+  }
+
+  n := 1
+  for _, item := range items[1:] {
+    if less(items[n-1], item) {
+      items[n] = item
+      n++
+    }
+  }
+  return items[:n]
+}
+
+// mergeSets Merge the sorted sets s1 and s2 keeping the items only in s1, in both or only in s2
+// according to the flags. The result is sorted according to less
+func mergeSets[T any](s1, s2 []T, less func(i1, i2 T) bool, only1, both, only2 bool) *List[T] {
+
+  ret := NewList[T]()
+  i, j := 0, 0
+  for i < len(s1) && j < len(s2) {
+    switch {
+    case less(s1[i], s2[j]):
+      if only1 {
+        ret.Append(s1[i])
+      }
+      i++
+    case less(s2[j], s1[i]):
+      if only2 {
+        ret.Append(s2[j])
+      }
+      j++
+    default:
+      if both {
+        ret.Append(s1[i])
+      }
+      i++
+      j++
+    }
+  }
+
+  for ; only1 && i < len(s1); i++ {
+    ret.Append(s1[i])
+  }
+  for ; only2 && j < len(s2); j++ {
+    ret.Append(s2[j])
+  }
+  return ret
+}
+
+// DistinctSorted Return a list with the items of seq sorted according to less and without equivalent
+// items. Of several equivalent items, the first one in seq is kept
+func DistinctSorted[T any](seq Sequence[T], less func(i1, i2 T) bool) *List[T] {
+  return NewList(sortedSet(seq, less)...)
+}
+
+// UnionSorted As Union but the items are compared with less, by sorted merge, and the result is
+// sorted according to less
+func UnionSorted[T any](s1, s2 Sequence[T], less func(i1, i2 T) bool) *List[T] {
+  return mergeSets(sortedSet(s1, less), sortedSet(s2, less), less, true, true, true)
+}
+
+// IntersectSorted As Intersect but the items are compared with less, by sorted merge, and the result
+// is sorted according to less
+func IntersectSorted[T any](s1, s2 Sequence[T], less func(i1, i2 T) bool) *List[T] {
+  return mergeSets(sortedSet(s1, less), sortedSet(s2, less), less, false, true, false)
+}
+
+// DifferenceSorted As Difference but the items are compared with less, by sorted merge, and the result
+// is sorted according to less
+func DifferenceSorted[T any](s1, s2 Sequence[T], less func(i1, i2 T) bool) *List[T] {
+  return mergeSets(sortedSet(s1, less), sortedSet(s2, less), less, true, false, false)
+}
+
+// SymmetricDifferenceSorted As SymmetricDifference but the items are compared with less, by sorted
+// merge, and the result is sorted according to less
+func SymmetricDifferenceSorted[T any](s1, s2 Sequence[T], less func(i1, i2 T) bool) *List[T] {
+  return mergeSets(sortedSet(s1, less), sortedSet(s2, less), less, true, false, true)
+}
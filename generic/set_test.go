@@ -0,0 +1,46 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "strings"
+  "testing"
+)
+
+func TestDistinct(t *testing.T) {
+
+  assert.Equal(t, Distinct[int](NewList(2, 2, 1, 2, 3, 1)).ToSlice(), []int{2, 1, 3})
+
+  firsts := DistinctBy[lineItem](lineItems(), func(l lineItem) string { return l.order })
+  assert.Equal(t, firsts.ToSlice(), []lineItem{{"o2", 10}, {"o1", 5}, {"o3", 1}})
+}
+
+func TestSetOperations(t *testing.T) {
+
+  a, b := NewList("x", "y", "z", "x"), NewTuple("w", "z", "x")
+  assert.Equal(t, Union[string](a, b).ToSlice(), []string{"x", "y", "z", "w"})
+  assert.Equal(t, Intersect[string](a, b).ToSlice(), []string{"x", "z"})
+  assert.Equal(t, Difference[string](a, b).ToSlice(), []string{"y"})
+  assert.Equal(t, Difference[string](b, a).ToSlice(), []string{"w"})
+  assert.Equal(t, SymmetricDifference[string](a, b).ToSlice(), []string{"y", "w"})
+  assert.Equal(t, SymmetricDifference[string](LinesOf(noSeek{strings.NewReader("x\ny\nz\n")}),
+    LinesOf(noSeek{strings.NewReader("z\nw\n")})).ToSlice(), []string{"x", "y", "w"})
+  assert.True(t, Intersect[string](a, NewList[string]()).IsEmpty())
+}
+
+func TestSortedSetOperations(t *testing.T) {
+
+  evens := Filter(createSet(), func(i int) bool { return i%2 == 0 })
+  triples := Filter(createSet(), func(i int) bool { return i%3 == 0 })
+
+  both := IntersectSorted[int](evens, triples, Less[int])
+  assert.Equal(t, both.ToSlice(), Filter(createSet(), func(i int) bool { return i%6 == 0 }).ToSlice())
+
+  union := UnionSorted[int](evens, triples, Less[int])
+  assert.True(t, IsSorted[int](union, Less[int]))
+  assert.Equal(t, union.Size(), evens.Size()+triples.Size()-both.Size())
+
+  assert.Equal(t, DifferenceSorted[int](evens, triples, Less[int]).Size(), evens.Size()-both.Size())
+  assert.Equal(t, SymmetricDifferenceSorted[int](evens, triples, Less[int]).Size(), union.Size()-both.Size())
+  assert.Equal(t, DistinctSorted[int](NewList(3, 1, 3, 2, 1), Less[int]).ToSlice(), []int{1, 2, 3})
+  assert.True(t, UnionSorted[int](NewList[int](), NewList[int](), Less[int]).IsEmpty())
+}
@@ -0,0 +1,89 @@
+package generic
+
+import (
+  "cmp"
+  "sort"
+)
+
+// Less Return a < b. Handy as comparator for the ordered types
+func Less[T cmp.Ordered](a, b T) bool {
+  return a < b
+}
+
+// SortInPlace Sort in place the tuple according to less. The sort is not stable
+func (tuple *Tuple[T]) SortInPlace(less func(i1, i2 T) bool) *Tuple[T] {
+  s := *tuple.l
+  sort.Slice(s, func(i, j int) bool {
+    return less(s[i], s[j])
+  })
+  return tuple
+}
+
+// Sort Return a sorted copy of tuple. The sort is not stable
+func (tuple *Tuple[T]) Sort(less func(i1, i2 T) bool) *Tuple[T] {
+  return tuple.Clone().SortInPlace(less)
+}
+
+// StableSortInPlace Sort in place the tuple according to less keeping the relative order of equal
+// items
+func (tuple *Tuple[T]) StableSortInPlace(less func(i1, i2 T) bool) *Tuple[T] {
+  s := *tuple.l
+  sort.SliceStable(s, func(i, j int) bool {
+    return less(s[i], s[j])
+  })
+  return tuple
+}
+
+// StableSort Return a sorted copy of tuple keeping the relative order of equal items
+func (tuple *Tuple[T]) StableSort(less func(i1, i2 T) bool) *Tuple[T] {
+  return tuple.Clone().StableSortInPlace(less)
+}
+
+// IsSorted Return true if the tuple is sorted according to less
+func (tuple *Tuple[T]) IsSorted(less func(i1, i2 T) bool) bool {
+  return IsSorted[T](tuple, less)
+}
+
+// SortByInPlace Stable sort in place of tuple according to the keys extracted with key and compared
+// with less. key is called once per item
+func SortByInPlace[T, K any](tuple *Tuple[T], key func(T) K, less func(k1, k2 K) bool) *Tuple[T] {
+
+  keyed := make([]Pair[K, T], 0, tuple.Size())
+  for _, item := range *tuple.l {
+    keyed = append(keyed, Pair[K, T]{Item1: key(item), Item2: item})
+  }
+
+  sort.SliceStable(keyed, func(i, j int) bool {
+    return less(keyed[i].Item1, keyed[j].Item1)
+  })
+
+  for i, p := range keyed {
+    (*tuple.l)[i] = p.Item2
+  }
+  return tuple
+}
+
+// SortBy Return a list with the items of seq stably sorted according to the keys extracted with key
+// and compared with less
+func SortBy[T, K any](seq Sequence[T], key func(T) K, less func(k1, k2 K) bool) *List[T] {
+  return NewList(*SortByInPlace(NewTuple(toSlice(seq)...), key, less).l...)
+}
+
+// Sorted Return a list with the items of seq stably sorted according to less
+func Sorted[T any](seq Sequence[T], less func(i1, i2 T) bool) *List[T] {
+  return NewList(*NewTuple(toSlice(seq)...).StableSortInPlace(less).l...)
+}
+
+// IsSorted Return true if every item of seq is not less than its predecessor according to less
+func IsSorted[T any](seq Sequence[T], less func(i1, i2 T) bool) bool {
+
+  first := true
+  var prev T
+  return seq.Traverse(func(item T) bool {
+    if !first && less(item, prev) {
+      return false
+    }
+    first, prev = false, item
+    return true
+  })
+}
@@ -0,0 +1,35 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "strings"
+  "testing"
+)
+
+func TestTuple_Sort(t *testing.T) {
+
+  tuple := NewTuple(4, 2, 3, 1, 0)
+  assert.Equal(t, tuple.Sort(Less[int]).ToSlice(), []int{0, 1, 2, 3, 4})
+  assert.False(t, tuple.IsSorted(Less[int]))
+  assert.True(t, tuple.SortInPlace(Less[int]).IsSorted(Less[int]))
+
+  words := NewTuple("Bb", "a", "bb", "A")
+  lessFold := func(s1, s2 string) bool { return strings.ToLower(s1) < strings.ToLower(s2) }
+  assert.Equal(t, words.StableSort(lessFold).ToSlice(), []string{"a", "A", "Bb", "bb"})
+  assert.Equal(t, words.StableSortInPlace(lessFold).ToSlice(), []string{"a", "A", "Bb", "bb"})
+}
+
+func TestSortBy(t *testing.T) {
+
+  items := NewTuple(lineItem{"o2", 10}, lineItem{"o1", 5}, lineItem{"o3", 7})
+  price := func(l lineItem) int { return l.price }
+
+  assert.Equal(t, Map[lineItem, string](SortBy[lineItem, int](items, price, Less[int]), byOrder).ToSlice(),
+    []string{"o1", "o3", "o2"})
+  assert.Equal(t, SortByInPlace(items, byOrder, Less[string]).Nth(0).order, "o1")
+
+  l := NewList(3, 1, 2)
+  assert.Equal(t, Sorted[int](l, Less[int]).ToSlice(), []int{1, 2, 3})
+  assert.True(t, IsSorted(createSet(), Less[int]))
+  assert.False(t, IsSorted[int](l, Less[int]))
+}
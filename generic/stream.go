@@ -0,0 +1,310 @@
+package generic
+
+// Stream A lazy sequence. Intermediate operations (Filter, Take, Drop, MapStream, ...) only compose
+// the pipeline; nothing is read from the source nor allocated until a terminal operation (ToList,
+// Traverse, ForEach, Find, Foldl, ...) is executed. Because Stream implements Sequence, every
+// combinator of the package may be used as terminal operation. A stream may be evaluated several
+// times, even simultaneously, as long as its source allows it (see StreamOf); each evaluation
+// traverses the source again
+type Stream[T any] struct {
+  pull func(ev *evaluation) func() (T, bool)
+  size func() int // number of items when it is known without evaluating the stream, or nil
+}
+
+// StreamIterator Iterator over a Stream. The current element is computed on demand. An iterator
+// abandoned before its end must be released with Stop
+type StreamIterator[T any] struct {
+  stream *Stream[T]
+  ev     *evaluation
+  next   func() (T, bool)
+  curr   T
+  ok     bool
+}
+
+// evaluation The iterators created by an evaluation of a stream that hold resources, such as
+// SeqIterator. They are stopped when the evaluation ends, even if it is cut short
+type evaluation struct {
+  its []interface{}
+}
+
+// add Register it to be stopped with the evaluation if it holds resources
+func (ev *evaluation) add(it interface{}) {
+  if _, ok := it.(interface{ Stop() }); ok {
+    ev.its = append(ev.its, it)
+  }
+}
+
+// Stop Release the registered iterators
+func (ev *evaluation) Stop() {
+  stopIterators(ev.its...)
+  ev.its = nil
+}
+
+// NewStream Return a lazy stream over the items of seq
+func NewStream[T any](seq Sequence[T]) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+    return pullOf(ev, seq)
+  }}
+}
+
+// pullOf Return a function returning, one per call, the items of seq. The items are read through an
+// iterator, so seq is not copied. The iterator is stopped with ev
+func pullOf[T any](ev *evaluation, seq Sequence[T]) func() (T, bool) {
+  return pullIterator(ev, seq.CreateIterator())
+}
+
+// pullIterator Return a function returning, one per call, the items of it from its current position.
+// The iterator is stopped with ev
+func pullIterator[T any](ev *evaluation, it SequentialIterator[T]) func() (T, bool) {
+  ev.add(it)
+  return func() (T, bool) {
+    if !it.HasCurr() {
+      var zero T
+      return zero, false
+    }
+    item := it.GetCurr()
+    it.Next()
+    return item, true
+  }
+}
+
+// iteratorStream Return a lazy stream over the items of the iterator returned by create. A new
+// iterator is created for each evaluation, so that evaluations may overlap
+func iteratorStream[T any](create func() SequentialIterator[T]) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+    return pullIterator(ev, create())
+  }}
+}
+
+// StreamOf Return a lazy stream over the items of it. The iterator is reset to its first element
+// each time the stream is evaluated. Since every evaluation shares it, the evaluations must not
+// overlap, as in Zip(s, s); use NewStream over a Sequence when they may
+func StreamOf[T any](it SequentialIterator[T]) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+    it.ResetFirst()
+    return pullIterator(ev, it)
+  }}
+}
+
+// MapStream Return a stream whose items are the items of stream transformed with transformation
+func MapStream[T, U any](stream *Stream[T], transformation func(T) U) *Stream[U] {
+  return &Stream[U]{size: stream.size, pull: func(ev *evaluation) func() (U, bool) {
+    next := stream.pull(ev)
+    return func() (U, bool) {
+      item, ok := next()
+      if !ok {
+        var zero U
+        return zero, false
+      }
+      return transformation(item), true
+    }
+  }}
+}
+
+// MapIfStream Return a stream with the items of stream satisfying predicate transformed with
+// transformation
+func MapIfStream[T, U any](stream *Stream[T], transformation func(T) U, predicate func(T) bool) *Stream[U] {
+  return MapStream(stream.Filter(predicate), transformation)
+}
+
+// Filter Return a stream containing the items satisfying predicate
+func (stream *Stream[T]) Filter(predicate func(T) bool) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+    next := stream.pull(ev)
+    return func() (T, bool) {
+      for item, ok := next(); ok; item, ok = next() {
+        if predicate(item) {
+          return item, true
+        }
+      }
+      var zero T
+      return zero, false
+    }
+  }}
+}
+
+// Take Return a stream containing the first n items. The source is not read beyond the n-th item
+func (stream *Stream[T]) Take(n int) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+    next := stream.pull(ev)
+    remaining := n
+    return func() (T, bool) {
+      if remaining <= 0 {
+        var zero T
+        return zero, false
+      }
+      remaining--
+      return next()
+    }
+  }}
+}
+
+// Drop Return a stream containing the items after the first n
+func (stream *Stream[T]) Drop(n int) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+    next := stream.pull(ev)
+    skipped := false
+    return func() (T, bool) {
+      if !skipped {
+        skipped = true
+        for i := 0; i < n; i++ {
+          if _, ok := next(); !ok {
+            break
+          }
+        }
+      }
+      return next()
+    }
+  }}
+}
+
+// TakeWhile Return a stream containing the longest prefix whose items satisfy predicate. The source
+// is not read beyond the first item not satisfying predicate
+func (stream *Stream[T]) TakeWhile(predicate func(T) bool) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+    next := stream.pull(ev)
+    done := false
+    return func() (T, bool) {
+      if !done {
+        if item, ok := next(); ok && predicate(item) {
+          return item, true
+        }
+        done = true
+      }
+      var zero T
+      return zero, false
+    }
+  }}
+}
+
+// DropWhile Return a stream containing the items after the longest prefix whose items satisfy predicate
+func (stream *Stream[T]) DropWhile(predicate func(T) bool) *Stream[T] {
+  return &Stream[T]{pull: func(ev *evaluation) func() (T, bool) {
+    next := stream.pull(ev)
+    dropped := false
+    return func() (T, bool) {
+      if !dropped {
+        dropped = true
+        for item, ok := next(); ok; item, ok = next() {
+          if !predicate(item) {
+            return item, true
+          }
+        }
+      }
+      return next()
+    }
+  }}
+}
+
+// Traverse Evaluate the stream and execute operation on each item. It stops as soon as operation
+// returns false, in which case the rest of the stream is not evaluated
+func (stream *Stream[T]) Traverse(operation func(T) bool) bool {
+  ev := &evaluation{}
+  defer ev.Stop()
+  next := stream.pull(ev)
+  for item, ok := next(); ok; item, ok = next() {
+    if !operation(item) {
+      return false
+    }
+  }
+  return true
+}
+
+// Size Evaluate the stream and return its number of items. The streams whose size is known in
+// advance, such as CartesianProduct and its MapStream, return it without being evaluated
+func (stream *Stream[T]) Size() int {
+  if stream.size != nil {
+    return stream.size()
+  }
+  n := 0
+  stream.Traverse(func(T) bool {
+    n++
+    return true
+  })
+  return n
+}
+
+// IsEmpty Return true if the stream has no items. Only the first item is evaluated
+func (stream *Stream[T]) IsEmpty() bool {
+  ev := &evaluation{}
+  defer ev.Stop()
+  _, ok := stream.pull(ev)()
+  return !ok
+}
+
+// CreateIterator Return an iterator to the stream compliant with the interface Sequence
+func (stream *Stream[T]) CreateIterator() SequentialIterator[T] {
+  it := &StreamIterator[T]{stream: stream}
+  it.ResetFirst()
+  return it
+}
+
+// ResetFirst Restart the evaluation of the stream
+func (it *StreamIterator[T]) ResetFirst() {
+  it.Stop()
+  it.ev = &evaluation{}
+  it.next = it.stream.pull(it.ev)
+  it.Next()
+}
+
+// HasCurr Return true if the iterator is on a element
+func (it *StreamIterator[T]) HasCurr() bool {
+  return it.ok
+}
+
+// GetCurr Return the element on which the iterator is positioned
+func (it *StreamIterator[T]) GetCurr() T {
+  return it.curr
+}
+
+// Next Evaluate the next item of the stream. The evaluation is stopped when its end is reached
+func (it *StreamIterator[T]) Next() {
+  if it.curr, it.ok = it.next(); !it.ok {
+    it.Stop()
+  }
+}
+
+// Stop Release the iterators of the evaluation. After Stop, HasCurr returns false. It must be called
+// if the iterator is abandoned before its end
+func (it *StreamIterator[T]) Stop() {
+  if it.ev != nil {
+    it.ev.Stop()
+  }
+  it.ok = false
+  it.next = func() (T, bool) {
+    var zero T
+    return zero, false
+  }
+}
+
+// ToList Evaluate the stream and return a list with its items
+func (stream *Stream[T]) ToList() *List[T] {
+  ret := NewList[T]()
+  stream.Traverse(func(item T) bool {
+    ret.Append(item)
+    return true
+  })
+  return ret
+}
+
+// ForEach Evaluate the stream executing operation on every item. Return stream
+func (stream *Stream[T]) ForEach(operation func(T)) *Stream[T] {
+  ForEach[T](stream, operation)
+  return stream
+}
+
+// Find Return the first item satisfying predicate. The stream is evaluated up to that item
+func (stream *Stream[T]) Find(predicate func(T) bool) (T, bool) {
+  return Find[T](stream, predicate)
+}
+
+// Exist Return true if some item satisfies predicate. The stream is evaluated up to that item
+func (stream *Stream[T]) Exist(predicate func(T) bool) bool {
+  return Exist[T](stream, predicate)
+}
+
+// All Return true if all the items satisfy predicate. The stream is evaluated up to the first
+// item not satisfying predicate
+func (stream *Stream[T]) All(predicate func(T) bool) bool {
+  return All[T](stream, predicate)
+}
@@ -0,0 +1,103 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "strconv"
+  "testing"
+)
+
+func TestStream_Lazy(t *testing.T) {
+
+  calls := 0
+  stream := MapStream(NewStream(createSet()), func(i int) string {
+    calls++
+    return strconv.Itoa(i)
+  }).Filter(func(s string) bool {
+    return len(s) == 2
+  }).Take(3)
+
+  assert.Equal(t, calls, 0)
+  assert.Equal(t, stream.ToList().ToSlice(), []string{"10", "11", "12"})
+  assert.Equal(t, calls, 13)
+
+  // a stream can be evaluated again
+  assert.Equal(t, stream.ToList().ToSlice(), []string{"10", "11", "12"})
+}
+
+func TestStream_TakeWhileDropWhile(t *testing.T) {
+
+  read := 0
+  stream := MapStream(NewStream(createSet()), func(i int) int {
+    read++
+    return i
+  })
+
+  small := stream.TakeWhile(func(i int) bool { return i < 5 })
+  assert.Equal(t, small.ToList().ToSlice(), []int{0, 1, 2, 3, 4})
+  assert.Equal(t, read, 6)
+
+  big := stream.DropWhile(func(i int) bool { return i < N-3 })
+  assert.Equal(t, big.ToList().ToSlice(), []int{N - 3, N - 2, N - 1})
+  assert.True(t, stream.DropWhile(func(int) bool { return true }).IsEmpty())
+}
+
+func TestStream_Terminals(t *testing.T) {
+
+  stream := NewStream(createSet()).Drop(90)
+
+  assert.Equal(t, stream.Size(), 10)
+  assert.Equal(t, Foldl[int, int](stream, 0, func(acu, i int) int { return acu + i }), 945)
+
+  item, ok := stream.Find(func(i int) bool { return i%7 == 0 })
+  assert.True(t, ok)
+  assert.Equal(t, item, 91)
+
+  _, ok = stream.Find(func(i int) bool { return i < 90 })
+  assert.False(t, ok)
+
+  assert.True(t, stream.All(func(i int) bool { return i >= 90 }))
+  assert.True(t, stream.Exist(func(i int) bool { return i == 99 }))
+  assert.False(t, stream.Take(0).Exist(func(int) bool { return true }))
+}
+
+func TestStreamOf(t *testing.T) {
+
+  stream := MapIfStream(StreamOf(NewTuple(1, 2, 3, 4, 5).CreateIterator()), func(i int) int {
+    return i * i
+  }, func(i int) bool {
+    return i%2 == 1
+  })
+
+  assert.Equal(t, stream.ToList().ToSlice(), []int{1, 9, 25})
+  assert.Equal(t, stream.ToList().ToSlice(), []int{1, 9, 25})
+}
+
+func TestStreamRelease(t *testing.T) {
+
+  running := 0
+  naturals := FromSeq(func(yield func(int) bool) {
+    running++
+    defer func() { running-- }()
+    for i := 0; yield(i); i++ {
+    }
+  })
+
+  // the evaluations cut short stop the iterators they created
+  assert.Equal(t, NewStream[int](naturals).Take(3).ToList().ToSlice(), []int{0, 1, 2})
+  item, _ := NewStream[int](naturals).Find(func(i int) bool { return i == 5 })
+  assert.Equal(t, item, 5)
+  assert.False(t, NewStream[int](naturals).IsEmpty())
+  merged := KWayMerge(Less[int], Sequence[int](naturals), Sequence[int](naturals))
+  assert.Equal(t, merged.Take(4).ToList().ToSlice(), []int{0, 0, 1, 1})
+  flat := FlatMapStream(NewStream[int](NewTuple(1, 2)), func(int) Sequence[int] { return naturals })
+  assert.Equal(t, flat.Take(2).ToList().ToSlice(), []int{0, 1})
+  assert.Equal(t, running, 0)
+
+  it := NewStream[int](naturals).CreateIterator().(*StreamIterator[int])
+  it.Next()
+  assert.Equal(t, it.GetCurr(), 1)
+  assert.Equal(t, running, 1)
+  it.Stop()
+  assert.False(t, it.HasCurr())
+  assert.Equal(t, running, 0)
+}
@@ -0,0 +1,234 @@
+package generic
+
+import (
+  "fmt"
+)
+
+// Tuple Represent a homogeneous tuple of items of type T
+type Tuple[T any] struct {
+  l *[]T
+}
+
+// NewTuple Return a new tuple with the received elements
+func NewTuple[T any](items ...T) *Tuple[T] {
+
+  s := make([]T, 0, len(items))
+  tuple := Tuple[T]{l: &s}
+  *tuple.l = append(*tuple.l, items...)
+  return &tuple
+}
+
+// TupleOf Return a tuple using *items as storage. Changes through the tuple are visible in *items and
+// vice versa
+func TupleOf[T any](items *[]T) *Tuple[T] {
+  return &Tuple[T]{l: items}
+}
+
+// BuildTuple Build a tuple for storing n elements
+func BuildTuple[T any](n int) *Tuple[T] {
+  s := make([]T, n)
+  return &Tuple[T]{l: &s}
+}
+
+// Set the i-th element of the tuple with item
+func (tuple *Tuple[T]) Set(i int, item T) {
+  (*tuple.l)[i] = item
+}
+
+// Traverse the tuple an executes operation on each element
+func (tuple *Tuple[T]) Traverse(operation func(T) bool) bool {
+  for _, item := range *tuple.l {
+    if !operation(item) {
+      return false
+    }
+  }
+  return true
+}
+
+// Append one or more elements to the tuple
+func (tuple *Tuple[T]) Append(item T, items ...T) *Tuple[T] {
+  *tuple.l = append(*tuple.l, item)
+  *tuple.l = append(*tuple.l, items...)
+  return tuple
+}
+
+// Size Return the length of the tuple
+func (tuple *Tuple[T]) Size() int {
+  return len(*tuple.l)
+}
+
+// Swap in O(1) two tuples
+func (tuple *Tuple[T]) Swap(other *Tuple[T]) *Tuple[T] {
+  tuple.l, other.l = other.l, tuple.l
+  return tuple
+}
+
+// IsEmpty Return true if the tuple is empty
+func (tuple *Tuple[T]) IsEmpty() bool {
+  return tuple.Size() == 0
+}
+
+// TupleIterator Iterator over a Tuple
+type TupleIterator[T any] struct {
+  tuple *Tuple[T]
+  pos   int
+}
+
+// CreateIterator Return an iterator to the tuple compliant with the interface Sequence
+func (tuple *Tuple[T]) CreateIterator() SequentialIterator[T] {
+  return NewTupleIterator(tuple)
+}
+
+// NewTupleIterator Return an new iterator to the tuple
+func NewTupleIterator[T any](tuple *Tuple[T]) *TupleIterator[T] {
+  return &TupleIterator[T]{
+    tuple: tuple,
+    pos:   0,
+  }
+}
+
+// HasCurr Return true if the iterator is on a element
+func (it *TupleIterator[T]) HasCurr() bool {
+  return it.pos < len(*it.tuple.l)
+}
+
+// GetCurr Return the element of which the iterator is positioned
+func (it *TupleIterator[T]) GetCurr() T {
+  return (*it.tuple.l)[it.pos]
+}
+
+// Next Advance the iterator to the next item of the tuple
+func (it *TupleIterator[T]) Next() {
+  it.pos++
+}
+
+// ResetFirst Reset the iterator to the first element
+func (it *TupleIterator[T]) ResetFirst() {
+  it.pos = 0
+}
+
+// Nth Return the n-th element of the tuple
+func (tuple *Tuple[T]) Nth(i int) T {
+  return (*tuple.l)[i]
+}
+
+// ToSlice Return a copy of the elements of the tuple
+func (tuple *Tuple[T]) ToSlice() []T {
+  return append(make([]T, 0, tuple.Size()), *tuple.l...)
+}
+
+func (tuple *Tuple[T]) validateInterval(i, j int) {
+
+  sz := tuple.Size()
+  if i < 0 || i >= sz {
+    panic(fmt.Sprintf("Invalid value for i = %d", i))
+  }
+
+  if j < 0 || j >= sz {
+    panic(fmt.Sprintf("Invalid value for j = %d", j))
+  }
+
+  if i > j {
+    panic(fmt.Sprintf("i = %d is greater than j = %d", i, j))
+  }
+}
+
+// ReverseInterval Reverse in place the subsequence between i and j
+func (tuple *Tuple[T]) ReverseInterval(i, j int) *Tuple[T] {
+
+  tuple.validateInterval(i, j)
+
+  for i <= j {
+    (*tuple.l)[i], (*tuple.l)[j] = (*tuple.l)[j], (*tuple.l)[i]
+    i++
+    j--
+  }
+
+  return tuple
+}
+
+// ReverseInPlace Reverse the tuple in place. An empty tuple is left as is
+func (tuple *Tuple[T]) ReverseInPlace() *Tuple[T] {
+  if tuple.IsEmpty() {
+    return tuple
+  }
+  return tuple.ReverseInterval(0, tuple.Size()-1)
+}
+
+// Reverse Return a reversed copy of tuple
+func (tuple *Tuple[T]) Reverse() *Tuple[T] {
+  return tuple.Clone().ReverseInPlace()
+}
+
+// validateRotateIndexes Validate the interval [i, j] and n, and return n reduced modulo the size of
+// the interval
+func (tuple *Tuple[T]) validateRotateIndexes(i, j, n int) int {
+
+  tuple.validateInterval(i, j)
+  if n < 0 {
+    panic(fmt.Sprintf("Invalid value for n = %d", n))
+  }
+
+  return n % (j - i + 1)
+}
+
+// RotateIntervalRightInPlace Rotate in place to right n positions the subsequence in [i, j]. n may
+// be greater than the size of the interval
+func (tuple *Tuple[T]) RotateIntervalRightInPlace(i, j, n int) *Tuple[T] {
+
+  if n = tuple.validateRotateIndexes(i, j, n); n == 0 {
+    return tuple
+  }
+
+  tuple.ReverseInterval(i, i+n-1)
+  tuple.ReverseInterval(i+n, j)
+  tuple.ReverseInterval(i, j)
+
+  return tuple
+}
+
+// RotateIntervalLeftInPlace Rotate in place to left n positions the subsequence in [i, j]. n may
+// be greater than the size of the interval
+func (tuple *Tuple[T]) RotateIntervalLeftInPlace(i, j, n int) *Tuple[T] {
+
+  if n = tuple.validateRotateIndexes(i, j, n); n == 0 {
+    return tuple
+  }
+
+  tuple.ReverseInterval(j-n+1, j)
+  tuple.ReverseInterval(i, j-n)
+  tuple.ReverseInterval(i, j)
+
+  return tuple
+}
+
+// RotateRightInPlace Rotate in place the sequence n positions to right. An empty tuple is left as is
+func (tuple *Tuple[T]) RotateRightInPlace(n int) *Tuple[T] {
+  if tuple.IsEmpty() {
+    return tuple
+  }
+  return tuple.RotateIntervalRightInPlace(0, tuple.Size()-1, n)
+}
+
+// RotateLeftInPlace Rotate in place the sequence n positions to left. An empty tuple is left as is
+func (tuple *Tuple[T]) RotateLeftInPlace(n int) *Tuple[T] {
+  if tuple.IsEmpty() {
+    return tuple
+  }
+  return tuple.RotateIntervalLeftInPlace(0, tuple.Size()-1, n)
+}
+
+// RotateRight Return a new tuple copy of tuple rotate n position to right
+func (tuple *Tuple[T]) RotateRight(n int) *Tuple[T] {
+  return tuple.Clone().RotateRightInPlace(n)
+}
+
+// RotateLeft Return a new tuple copy of tuple rotate n position to left
+func (tuple *Tuple[T]) RotateLeft(n int) *Tuple[T] {
+  return tuple.Clone().RotateLeftInPlace(n)
+}
+
+// Clone Return a copy of tuple
+func (tuple *Tuple[T]) Clone() *Tuple[T] {
+  return NewTuple(*tuple.l...)
+}
@@ -0,0 +1,120 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestNewTuple(t *testing.T) {
+
+  tuple4 := NewTuple(1, 2, 3, 4)
+  assert.Equal(t, tuple4.Size(), 4)
+  assert.Equal(t, tuple4.Nth(0), 1)
+
+  tuple := BuildTuple[string](3)
+  tuple.Set(1, "B")
+  assert.Equal(t, tuple.ToSlice(), []string{"", "B", ""})
+
+  tuple.Append("D", "E")
+  assert.Equal(t, tuple.Size(), 5)
+  assert.Equal(t, Position[string](tuple, func(s string) bool { return s == "E" }), 4)
+}
+
+func TestTuple_Swap(t *testing.T) {
+
+  t1, t2 := NewTuple(1, 2), NewTuple(3, 4, 5)
+  t1.Swap(t2)
+  assert.Equal(t, t1.ToSlice(), []int{3, 4, 5})
+  assert.Equal(t, t2.ToSlice(), []int{1, 2})
+}
+
+func TestTuple_ReverseInterval(t *testing.T) {
+
+  tuple := NewTuple(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+  tuple.ReverseInterval(1, 5)
+  assert.Equal(t, tuple.ToSlice(), []int{0, 5, 4, 3, 2, 1, 6, 7, 8, 9})
+
+  assert.Panics(t, func() { tuple.ReverseInterval(5, 3) })
+  assert.Panics(t, func() { tuple.ReverseInterval(-1, 3) })
+  assert.Panics(t, func() { tuple.ReverseInterval(1, -3) })
+  assert.Panics(t, func() { tuple.ReverseInterval(5, 13) })
+}
+
+func TestTuple_Reverse(t *testing.T) {
+
+  tuple := NewTuple(0, 1, 2, 3, 4, 5)
+  reversed := tuple.Reverse()
+  assert.Equal(t, reversed.ToSlice(), []int{5, 4, 3, 2, 1, 0})
+  assert.Equal(t, tuple.ToSlice(), []int{0, 1, 2, 3, 4, 5})
+
+  tuple.ReverseInPlace()
+  assert.Equal(t, tuple.ToSlice(), reversed.ToSlice())
+
+  assert.True(t, NewTuple[int]().ReverseInPlace().IsEmpty())
+  assert.True(t, NewTuple[int]().Reverse().IsEmpty())
+}
+
+func TestTuple_Rotations(t *testing.T) {
+
+  tuple := NewTuple(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+  assert.Equal(t, tuple.RotateRight(3).ToSlice(), []int{3, 4, 5, 6, 7, 8, 9, 0, 1, 2})
+  assert.Equal(t, tuple.RotateLeft(3).ToSlice(), []int{7, 8, 9, 0, 1, 2, 3, 4, 5, 6})
+  assert.Equal(t, tuple.RotateRight(3).RotateLeft(3).ToSlice(), tuple.ToSlice())
+
+  clone := tuple.Clone()
+  tuple.RotateIntervalRightInPlace(2, 5, 2)
+  assert.Equal(t, tuple.ToSlice(), []int{0, 1, 4, 5, 2, 3, 6, 7, 8, 9})
+  tuple.RotateIntervalLeftInPlace(2, 5, 2)
+  assert.Equal(t, tuple.ToSlice(), clone.ToSlice())
+
+  // n is reduced modulo the size of the interval
+  assert.Equal(t, tuple.RotateRight(0).ToSlice(), clone.ToSlice())
+  assert.Equal(t, tuple.RotateRight(tuple.Size()).ToSlice(), clone.ToSlice())
+  assert.Equal(t, tuple.RotateLeft(13).ToSlice(), tuple.RotateLeft(3).ToSlice())
+  tuple.RotateIntervalRightInPlace(2, 5, 6)
+  assert.Equal(t, tuple.ToSlice(), []int{0, 1, 4, 5, 2, 3, 6, 7, 8, 9})
+  assert.Panics(t, func() { tuple.RotateRight(-1) })
+  assert.True(t, NewTuple[int]().RotateRight(2).IsEmpty())
+  assert.True(t, NewTuple[int]().RotateLeftInPlace(0).IsEmpty())
+}
+
+func TestZip3Unzip3(t *testing.T) {
+
+  l1 := NewTuple(1, 2, 3, 4, 5)
+  l2 := NewTuple("A", "B", "C", "D")
+  l3 := NewTuple(-5.0, -4.0, -3.0, -2.0, -1.0)
+
+  zl := Zip3[int, string, float64](l1, l2, l3)
+  assert.Equal(t, zl.Size(), 4)
+  assert.Equal(t, zl.First(), Triple[int, string, float64]{1, "A", -5.0})
+
+  r1, r2, r3 := Unzip3[int, string, float64](zl)
+  assert.Equal(t, r1.ToSlice(), []int{1, 2, 3, 4})
+  assert.Equal(t, r2.ToSlice(), l2.ToSlice())
+  assert.Equal(t, r3.ToSlice(), []float64{-5, -4, -3, -2})
+}
+
+func TestZip8Unzip8(t *testing.T) {
+
+  l := NewTuple(1, 2, 3)
+  s := NewTuple("a", "b", "c")
+  b := NewTuple(true, false, true)
+
+  zl := Zip8[int, string, bool, int, string, bool, int, string](l, s, b, l, s, b, l, s)
+  assert.Equal(t, zl.Size(), 3)
+  assert.Equal(t, zl.Last(), Tuple8[int, string, bool, int, string, bool, int, string]{
+    3, "c", true, 3, "c", true, 3, "c"})
+
+  r1, r2, r3, r4, r5, r6, r7, r8 := Unzip8[int, string, bool, int, string, bool, int, string](zl)
+  for _, r := range []*List[int]{r1, r4, r7} {
+    assert.Equal(t, r.ToSlice(), l.ToSlice())
+  }
+  for _, r := range []*List[string]{r2, r5, r8} {
+    assert.Equal(t, r.ToSlice(), s.ToSlice())
+  }
+  for _, r := range []*List[bool]{r3, r6} {
+    assert.Equal(t, r.ToSlice(), b.ToSlice())
+  }
+}
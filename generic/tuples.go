@@ -0,0 +1,366 @@
+package generic
+
+// Triple A heterogeneous tuple of three typed items. Returned by Zip3
+type Triple[A, B, C any] struct {
+  Item1 A
+  Item2 B
+  Item3 C
+}
+
+// Tuple4 A heterogeneous tuple of four typed items. Returned by Zip4
+type Tuple4[A, B, C, D any] struct {
+  Item1 A
+  Item2 B
+  Item3 C
+  Item4 D
+}
+
+// Tuple5 A heterogeneous tuple of five typed items. Returned by Zip5
+type Tuple5[A, B, C, D, E any] struct {
+  Item1 A
+  Item2 B
+  Item3 C
+  Item4 D
+  Item5 E
+}
+
+// Tuple6 A heterogeneous tuple of six typed items. Returned by Zip6
+type Tuple6[A, B, C, D, E, F any] struct {
+  Item1 A
+  Item2 B
+  Item3 C
+  Item4 D
+  Item5 E
+  Item6 F
+}
+
+// Tuple7 A heterogeneous tuple of seven typed items. Returned by Zip7
+type Tuple7[A, B, C, D, E, F, G any] struct {
+  Item1 A
+  Item2 B
+  Item3 C
+  Item4 D
+  Item5 E
+  Item6 F
+  Item7 G
+}
+
+// Tuple8 A heterogeneous tuple of eight typed items. Returned by Zip8
+type Tuple8[A, B, C, D, E, F, G, H any] struct {
+  Item1 A
+  Item2 B
+  Item3 C
+  Item4 D
+  Item5 E
+  Item6 F
+  Item7 G
+  Item8 H
+}
+
+// allHaveCurr Return true if all the iterators are positioned on an element
+func allHaveCurr(its ...interface{ HasCurr() bool }) bool {
+  for _, it := range its {
+    if !it.HasCurr() {
+      return false
+    }
+  }
+  return true
+}
+
+// nextAll Advance all the iterators
+func nextAll(its ...interface{ Next() }) {
+  for _, it := range its {
+    it.Next()
+  }
+}
+
+// Zip3 Zip three sequences into one list of Triple. The result is truncated to the shortest
+// sequence
+func Zip3[A, B, C any](s1 Sequence[A], s2 Sequence[B], s3 Sequence[C]) *List[Triple[A, B, C]] {
+
+  ret := NewList[Triple[A, B, C]]()
+
+  it1 := s1.CreateIterator()
+  it2 := s2.CreateIterator()
+  it3 := s3.CreateIterator()
+  defer stopIterators(it1, it2, it3)
+  for ; allHaveCurr(it1, it2, it3); nextAll(it1, it2, it3) {
+    ret.Append(Triple[A, B, C]{
+      Item1: it1.GetCurr(),
+      Item2: it2.GetCurr(),
+      Item3: it3.GetCurr(),
+    })
+  }
+
+  return ret
+}
+
+// Unzip3 Unzip a sequence of Triple into three separated lists
+func Unzip3[A, B, C any](seq Sequence[Triple[A, B, C]]) (*List[A], *List[B], *List[C]) {
+
+  l1 := NewList[A]()
+  l2 := NewList[B]()
+  l3 := NewList[C]()
+
+  ForEach(seq, func(curr Triple[A, B, C]) {
+    l1.Append(curr.Item1)
+    l2.Append(curr.Item2)
+    l3.Append(curr.Item3)
+  })
+
+  return l1, l2, l3
+}
+
+// Zip4 Zip four sequences into one list of Tuple4. The result is truncated to the shortest
+// sequence
+func Zip4[A, B, C, D any](s1 Sequence[A], s2 Sequence[B], s3 Sequence[C],
+  s4 Sequence[D]) *List[Tuple4[A, B, C, D]] {
+
+  ret := NewList[Tuple4[A, B, C, D]]()
+
+  it1 := s1.CreateIterator()
+  it2 := s2.CreateIterator()
+  it3 := s3.CreateIterator()
+  it4 := s4.CreateIterator()
+  defer stopIterators(it1, it2, it3, it4)
+  for ; allHaveCurr(it1, it2, it3, it4); nextAll(it1, it2, it3, it4) {
+    ret.Append(Tuple4[A, B, C, D]{
+      Item1: it1.GetCurr(),
+      Item2: it2.GetCurr(),
+      Item3: it3.GetCurr(),
+      Item4: it4.GetCurr(),
+    })
+  }
+
+  return ret
+}
+
+// Unzip4 Unzip a sequence of Tuple4 into four separated lists
+func Unzip4[A, B, C, D any](seq Sequence[Tuple4[A, B, C, D]]) (*List[A], *List[B], *List[C], *List[D]) {
+
+  l1 := NewList[A]()
+  l2 := NewList[B]()
+  l3 := NewList[C]()
+  l4 := NewList[D]()
+
+  ForEach(seq, func(curr Tuple4[A, B, C, D]) {
+    l1.Append(curr.Item1)
+    l2.Append(curr.Item2)
+    l3.Append(curr.Item3)
+    l4.Append(curr.Item4)
+  })
+
+  return l1, l2, l3, l4
+}
+
+// Zip5 Zip five sequences into one list of Tuple5. The result is truncated to the shortest
+// sequence
+func Zip5[A, B, C, D, E any](s1 Sequence[A], s2 Sequence[B], s3 Sequence[C], s4 Sequence[D],
+  s5 Sequence[E]) *List[Tuple5[A, B, C, D, E]] {
+
+  ret := NewList[Tuple5[A, B, C, D, E]]()
+
+  it1 := s1.CreateIterator()
+  it2 := s2.CreateIterator()
+  it3 := s3.CreateIterator()
+  it4 := s4.CreateIterator()
+  it5 := s5.CreateIterator()
+  defer stopIterators(it1, it2, it3, it4, it5)
+  for ; allHaveCurr(it1, it2, it3, it4, it5); nextAll(it1, it2, it3, it4, it5) {
+    ret.Append(Tuple5[A, B, C, D, E]{
+      Item1: it1.GetCurr(),
+      Item2: it2.GetCurr(),
+      Item3: it3.GetCurr(),
+      Item4: it4.GetCurr(),
+      Item5: it5.GetCurr(),
+    })
+  }
+
+  return ret
+}
+
+// Unzip5 Unzip a sequence of Tuple5 into five separated lists
+func Unzip5[A, B, C, D, E any](seq Sequence[Tuple5[A, B, C, D, E]]) (
+  *List[A], *List[B], *List[C], *List[D], *List[E]) {
+
+  l1 := NewList[A]()
+  l2 := NewList[B]()
+  l3 := NewList[C]()
+  l4 := NewList[D]()
+  l5 := NewList[E]()
+
+  ForEach(seq, func(curr Tuple5[A, B, C, D, E]) {
+    l1.Append(curr.Item1)
+    l2.Append(curr.Item2)
+    l3.Append(curr.Item3)
+    l4.Append(curr.Item4)
+    l5.Append(curr.Item5)
+  })
+
+  return l1, l2, l3, l4, l5
+}
+
+// Zip6 Zip six sequences into one list of Tuple6. The result is truncated to the shortest
+// sequence
+func Zip6[A, B, C, D, E, F any](s1 Sequence[A], s2 Sequence[B], s3 Sequence[C], s4 Sequence[D],
+  s5 Sequence[E], s6 Sequence[F]) *List[Tuple6[A, B, C, D, E, F]] {
+
+  ret := NewList[Tuple6[A, B, C, D, E, F]]()
+
+  it1 := s1.CreateIterator()
+  it2 := s2.CreateIterator()
+  it3 := s3.CreateIterator()
+  it4 := s4.CreateIterator()
+  it5 := s5.CreateIterator()
+  it6 := s6.CreateIterator()
+  defer stopIterators(it1, it2, it3, it4, it5, it6)
+  for ; allHaveCurr(it1, it2, it3, it4, it5, it6); nextAll(it1, it2, it3, it4, it5, it6) {
+    ret.Append(Tuple6[A, B, C, D, E, F]{
+      Item1: it1.GetCurr(),
+      Item2: it2.GetCurr(),
+      Item3: it3.GetCurr(),
+      Item4: it4.GetCurr(),
+      Item5: it5.GetCurr(),
+      Item6: it6.GetCurr(),
+    })
+  }
+
+  return ret
+}
+
+// Unzip6 Unzip a sequence of Tuple6 into six separated lists
+func Unzip6[A, B, C, D, E, F any](seq Sequence[Tuple6[A, B, C, D, E, F]]) (
+  *List[A], *List[B], *List[C], *List[D], *List[E], *List[F]) {
+
+  l1 := NewList[A]()
+  l2 := NewList[B]()
+  l3 := NewList[C]()
+  l4 := NewList[D]()
+  l5 := NewList[E]()
+  l6 := NewList[F]()
+
+  ForEach(seq, func(curr Tuple6[A, B, C, D, E, F]) {
+    l1.Append(curr.Item1)
+    l2.Append(curr.Item2)
+    l3.Append(curr.Item3)
+    l4.Append(curr.Item4)
+    l5.Append(curr.Item5)
+    l6.Append(curr.Item6)
+  })
+
+  return l1, l2, l3, l4, l5, l6
+}
+
+// Zip7 Zip seven sequences into one list of Tuple7. The result is truncated to the shortest
+// sequence
+func Zip7[A, B, C, D, E, F, G any](s1 Sequence[A], s2 Sequence[B], s3 Sequence[C], s4 Sequence[D],
+  s5 Sequence[E], s6 Sequence[F], s7 Sequence[G]) *List[Tuple7[A, B, C, D, E, F, G]] {
+
+  ret := NewList[Tuple7[A, B, C, D, E, F, G]]()
+
+  it1 := s1.CreateIterator()
+  it2 := s2.CreateIterator()
+  it3 := s3.CreateIterator()
+  it4 := s4.CreateIterator()
+  it5 := s5.CreateIterator()
+  it6 := s6.CreateIterator()
+  it7 := s7.CreateIterator()
+  defer stopIterators(it1, it2, it3, it4, it5, it6, it7)
+  for ; allHaveCurr(it1, it2, it3, it4, it5, it6, it7); nextAll(it1, it2, it3, it4, it5, it6, it7) {
+    ret.Append(Tuple7[A, B, C, D, E, F, G]{
+      Item1: it1.GetCurr(),
+      Item2: it2.GetCurr(),
+      Item3: it3.GetCurr(),
+      Item4: it4.GetCurr(),
+      Item5: it5.GetCurr(),
+      Item6: it6.GetCurr(),
+      Item7: it7.GetCurr(),
+    })
+  }
+
+  return ret
+}
+
+// Unzip7 Unzip a sequence of Tuple7 into seven separated lists
+func Unzip7[A, B, C, D, E, F, G any](seq Sequence[Tuple7[A, B, C, D, E, F, G]]) (
+  *List[A], *List[B], *List[C], *List[D], *List[E], *List[F], *List[G]) {
+
+  l1 := NewList[A]()
+  l2 := NewList[B]()
+  l3 := NewList[C]()
+  l4 := NewList[D]()
+  l5 := NewList[E]()
+  l6 := NewList[F]()
+  l7 := NewList[G]()
+
+  ForEach(seq, func(curr Tuple7[A, B, C, D, E, F, G]) {
+    l1.Append(curr.Item1)
+    l2.Append(curr.Item2)
+    l3.Append(curr.Item3)
+    l4.Append(curr.Item4)
+    l5.Append(curr.Item5)
+    l6.Append(curr.Item6)
+    l7.Append(curr.Item7)
+  })
+
+  return l1, l2, l3, l4, l5, l6, l7
+}
+
+// Zip8 Zip eight sequences into one list of Tuple8. The result is truncated to the shortest
+// sequence
+func Zip8[A, B, C, D, E, F, G, H any](s1 Sequence[A], s2 Sequence[B], s3 Sequence[C], s4 Sequence[D],
+  s5 Sequence[E], s6 Sequence[F], s7 Sequence[G], s8 Sequence[H]) *List[Tuple8[A, B, C, D, E, F, G, H]] {
+
+  ret := NewList[Tuple8[A, B, C, D, E, F, G, H]]()
+
+  it1 := s1.CreateIterator()
+  it2 := s2.CreateIterator()
+  it3 := s3.CreateIterator()
+  it4 := s4.CreateIterator()
+  it5 := s5.CreateIterator()
+  it6 := s6.CreateIterator()
+  it7 := s7.CreateIterator()
+  it8 := s8.CreateIterator()
+  defer stopIterators(it1, it2, it3, it4, it5, it6, it7, it8)
+  for ; allHaveCurr(it1, it2, it3, it4, it5, it6, it7, it8); nextAll(it1, it2, it3, it4, it5, it6, it7, it8) {
+    ret.Append(Tuple8[A, B, C, D, E, F, G, H]{
+      Item1: it1.GetCurr(),
+      Item2: it2.GetCurr(),
+      Item3: it3.GetCurr(),
+      Item4: it4.GetCurr(),
+      Item5: it5.GetCurr(),
+      Item6: it6.GetCurr(),
+      Item7: it7.GetCurr(),
+      Item8: it8.GetCurr(),
+    })
+  }
+
+  return ret
+}
+
+// Unzip8 Unzip a sequence of Tuple8 into eight separated lists
+func Unzip8[A, B, C, D, E, F, G, H any](seq Sequence[Tuple8[A, B, C, D, E, F, G, H]]) (
+  *List[A], *List[B], *List[C], *List[D], *List[E], *List[F], *List[G], *List[H]) {
+
+  l1 := NewList[A]()
+  l2 := NewList[B]()
+  l3 := NewList[C]()
+  l4 := NewList[D]()
+  l5 := NewList[E]()
+  l6 := NewList[F]()
+  l7 := NewList[G]()
+  l8 := NewList[H]()
+
+  ForEach(seq, func(curr Tuple8[A, B, C, D, E, F, G, H]) {
+    l1.Append(curr.Item1)
+    l2.Append(curr.Item2)
+    l3.Append(curr.Item3)
+    l4.Append(curr.Item4)
+    l5.Append(curr.Item5)
+    l6.Append(curr.Item6)
+    l7.Append(curr.Item7)
+    l8.Append(curr.Item8)
+  })
+
+  return l1, l2, l3, l4, l5, l6, l7, l8
+}
@@ -0,0 +1,87 @@
+package generic
+
+import (
+  "fmt"
+)
+
+// Chunks Return a lazy stream of tuples with the consecutive items of seq grouped by n. The last tuple
+// is shorter if the size of seq is not multiple of n
+func Chunks[T any](seq Sequence[T], n int) *Stream[*Tuple[T]] {
+
+  if n <= 0 {
+    panic(fmt.Sprintf("Invalid chunk size n = %d", n))
+  }
+
+  return &Stream[*Tuple[T]]{pull: func(ev *evaluation) func() (*Tuple[T], bool) {
+    next := pullOf(ev, seq)
+    return func() (*Tuple[T], bool) {
+      chunk := make([]T, 0, n)
+      for item, ok := next(); ok; item, ok = next() {
+        if chunk = append(chunk, item); len(chunk) == n {
+          break
+        }
+      }
+      return TupleOf(&chunk), len(chunk) > 0
+    }
+  }}
+}
+
+// Chunk Return a list of tuples with the consecutive items of seq grouped by n. The last tuple is
+// shorter if the size of seq is not multiple of n
+func Chunk[T any](seq Sequence[T], n int) *List[*Tuple[T]] {
+  return Chunks(seq, n).ToList()
+}
+
+// SlidingWindow Return a lazy stream of the windows of size consecutive items of seq. The first
+// window starts at the first item and each following one starts step items after the previous one.
+// Trailing windows with less than size items are not generated. Only one window is kept in memory
+func SlidingWindow[T any](seq Sequence[T], size, step int) *Stream[*Tuple[T]] {
+
+  if size <= 0 {
+    panic(fmt.Sprintf("Invalid window size = %d", size))
+  }
+
+  if step <= 0 {
+    panic(fmt.Sprintf("Invalid window step = %d", step))
+  }
+
+  return &Stream[*Tuple[T]]{pull: func(ev *evaluation) func() (*Tuple[T], bool) {
+    next := pullOf(ev, seq)
+    var window []T
+    fill := func() bool { // complete window up to size items
+      for len(window) < size {
+        item, ok := next()
+        if !ok {
+          return false
+        }
+        window = append(window, item)
+      }
+      return true
+    }
+    return func() (*Tuple[T], bool) {
+      if window == nil {
+        window = make([]T, 0, size)
+      } else if step < size {
+        window = append(window[:0], window[step:]...)
+      } else {
+        window = window[:0]
+        for i := size; i < step; i++ { // skip the items between windows
+          if _, ok := next(); !ok {
+            return nil, false
+          }
+        }
+      }
+      if !fill() {
+        return nil, false
+      }
+      return NewTuple(window...), true
+    }
+  }}
+}
+
+// Pairwise Return a lazy stream with the pairs of adjacent items of seq: (i1, i2), (i2, i3), ...
+func Pairwise[T any](seq Sequence[T]) *Stream[Pair[T, T]] {
+  return MapStream(SlidingWindow(seq, 2, 1), func(window *Tuple[T]) Pair[T, T] {
+    return Pair[T, T]{Item1: window.Nth(0), Item2: window.Nth(1)}
+  })
+}
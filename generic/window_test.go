@@ -0,0 +1,36 @@
+package generic
+
+import (
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestChunks(t *testing.T) {
+
+  list := NewList(1, 2, 3, 4, 5)
+  assert.Equal(t, collect(Chunks[int](list, 2)), [][]int{{1, 2}, {3, 4}, {5}})
+  assert.Equal(t, collect(Chunks[int](list, 5)), [][]int{{1, 2, 3, 4, 5}})
+  assert.Equal(t, Chunk[int](list, 1).Size(), 5)
+  assert.Equal(t, Chunk[int](createSet(), 7).Size(), (N+6)/7)
+}
+
+func TestSlidingWindowLazy(t *testing.T) {
+
+  read := 0
+  source := MapStream(NewStream[int](createSet()), func(i int) int {
+    read++
+    return i
+  })
+  windows := collect(SlidingWindow[int](source, 3, 2).Take(2))
+  assert.Equal(t, windows, [][]int{{0, 1, 2}, {2, 3, 4}})
+  assert.Less(t, read, 10) // only the items of the two windows plus the lookahead of the iterator
+
+  assert.Equal(t, collect(SlidingWindow[int](NewTuple(1, 2, 3, 4, 5), 1, 3)), [][]int{{1}, {4}})
+}
+
+func TestPairwise(t *testing.T) {
+
+  pairs := Pairwise[int](NewTuple(1, 4, 9)).ToList().ToSlice()
+  assert.Equal(t, pairs, []Pair[int, int]{{Item1: 1, Item2: 4}, {Item1: 4, Item2: 9}})
+  assert.True(t, Pairwise[int](NewTuple[int]()).IsEmpty())
+}
@@ -1,6 +1,6 @@
 module github.com/lrleon/FunctionalLib
 
-go 1.20
+go 1.23
 
 require (
 	github.com/lrleon/Slist v1.0.1
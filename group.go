@@ -0,0 +1,67 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// OrderedMap A map from keys to values traversed in insertion order of the keys or sorted by a comparator.
+// Returned by GroupBy, CountBy and AggregateBy
+type OrderedMap = generic.OrderedMap[interface{}, interface{}]
+
+// NewOrderedMap Return an empty map traversed in insertion order of the keys, which must be comparable
+func NewOrderedMap() *OrderedMap {
+  return generic.NewOrderedMap[interface{}, interface{}]()
+}
+
+// NewSortedMap Return an empty map traversed in the order of the keys given by less
+func NewSortedMap(less func(k1, k2 interface{}) bool) *OrderedMap {
+  return generic.NewSortedMap[interface{}, interface{}](less)
+}
+
+func appendToSlist(acu, item interface{}) interface{} {
+  if acu == nil {
+    return Seq.New(item)
+  }
+  return acu.(*Seq.Slist).Append(item)
+}
+
+func increment(acu, _ interface{}) interface{} {
+  return acu.(int) + 1
+}
+
+// AggregateBy Bucket the items of seq by key and fold each bucket with f starting from initVal. Return a map
+// from every key to the folded value of its bucket, in insertion order of the keys
+func AggregateBy(seq Sequence, key func(interface{}) interface{}, initVal interface{},
+  f func(acu, item interface{}) interface{}) *OrderedMap {
+
+  return generic.AggregateBy(generic.Of[interface{}](seq), key, initVal, f)
+}
+
+// AggregateBySorted As AggregateBy but the keys are sorted according to less
+func AggregateBySorted(seq Sequence, key func(interface{}) interface{}, less func(k1, k2 interface{}) bool,
+  initVal interface{}, f func(acu, item interface{}) interface{}) *OrderedMap {
+
+  return generic.AggregateBySorted(generic.Of[interface{}](seq), key, less, initVal, f)
+}
+
+// GroupBy Bucket the items of seq by key. Return a map from every key to a *Seq.Slist with the items having
+// it, in insertion order of the keys
+func GroupBy(seq Sequence, key func(interface{}) interface{}) *OrderedMap {
+  return AggregateBy(seq, key, nil, appendToSlist)
+}
+
+// GroupBySorted As GroupBy but the keys are sorted according to less
+func GroupBySorted(seq Sequence, key func(interface{}) interface{}, less func(k1, k2 interface{}) bool) *OrderedMap {
+  return AggregateBySorted(seq, key, less, nil, appendToSlist)
+}
+
+// CountBy Return a map from every key to the number of items of seq having it, in insertion order of the keys
+func CountBy(seq Sequence, key func(interface{}) interface{}) *OrderedMap {
+  return AggregateBy(seq, key, 0, increment)
+}
+
+// CountBySorted As CountBy but the keys are sorted according to less
+func CountBySorted(seq Sequence, key func(interface{}) interface{}, less func(k1, k2 interface{}) bool) *OrderedMap {
+  return AggregateBySorted(seq, key, less, 0, increment)
+}
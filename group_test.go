@@ -0,0 +1,64 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func mod3(i interface{}) interface{} {
+  return i.(int) % 3
+}
+
+func TestGroupBy(t *testing.T) {
+
+  groups := GroupBy(NewTuple(5, 3, 4, 1, 0, 2), mod3)
+
+  assert.Equal(t, groups.Size(), 3)
+  assert.Equal(t, groups.Keys().ToSlice(), []interface{}{2, 0, 1})
+  assert.Equal(t, groups.Get(2).MustGet().(*Seq.Slist).ToSlice(), []interface{}{5, 2})
+  assert.Equal(t, groups.Get(0).MustGet().(*Seq.Slist).ToSlice(), []interface{}{3, 0})
+  assert.True(t, groups.Get(3).IsNone())
+
+  sorted := GroupBySorted(NewTuple(5, 3, 4, 1, 0, 2), mod3, cmpInt)
+  assert.Equal(t, sorted.Keys().ToSlice(), []interface{}{0, 1, 2})
+  assert.Equal(t, sorted.Get(1).MustGet().(*Seq.Slist).ToSlice(), []interface{}{4, 1})
+}
+
+func TestCountBy(t *testing.T) {
+
+  counts := CountBy(createSet(), func(i interface{}) interface{} {
+    return i.(int) < 10
+  })
+  assert.Equal(t, counts.Keys().ToSlice(), []interface{}{true, false})
+  assert.Equal(t, counts.Values().ToSlice(), []interface{}{10, N - 10})
+
+  sorted := CountBySorted(Seq.New("bb", "a", "ccc", "dd", "e"), func(s interface{}) interface{} {
+    return len(s.(string))
+  }, cmpInt)
+  assert.Equal(t, sorted.Keys().ToSlice(), []interface{}{1, 2, 3})
+  assert.Equal(t, sorted.Values().ToSlice(), []interface{}{2, 2, 1})
+}
+
+func TestAggregateBy(t *testing.T) {
+
+  sum := func(acu, item interface{}) interface{} {
+    return acu.(int) + item.(int)
+  }
+
+  sums := AggregateBy(createSet(), mod3, 0, sum)
+  total := 0
+  sums.Traverse(func(p Pair) bool {
+    total += p.Item2.(int)
+    return true
+  })
+  assert.Equal(t, total, N*(N-1)/2)
+
+  sorted := AggregateBySorted(NewTuple(1, 2, 3, 4, 5, 6), mod3, cmpInt, 0, sum)
+  assert.Equal(t, sorted.Values().ToSlice(), []interface{}{9, 5, 7})
+
+  m := NewOrderedMap().Put("b", 1).Put("a", 2).Put("b", 3)
+  assert.Equal(t, m.Keys().ToSlice(), []interface{}{"b", "a"})
+  assert.Equal(t, m.Get("b").MustGet(), 3)
+  assert.True(t, NewSortedMap(cmpInt).IsEmpty())
+}
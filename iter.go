@@ -0,0 +1,108 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  "iter"
+  "slices"
+)
+
+// Values Return an iter.Seq yielding the items of seq, so that it can be used in a range loop
+func Values(seq Sequence) iter.Seq[interface{}] {
+  return generic.Values(generic.Of[interface{}](seq))
+}
+
+// IteratorValues Return an iter.Seq yielding the items of it. The iterator is reset to its first element
+// each time the returned iter.Seq is ranged
+func IteratorValues(it SequentialIterator) iter.Seq[interface{}] {
+  return func(yield func(interface{}) bool) {
+    for it.ResetFirst(); it.HasCurr(); it.Next() {
+      if !yield(it.GetCurr()) {
+        return
+      }
+    }
+  }
+}
+
+// Enumerate Return an iter.Seq2 yielding the pairs (index, item) of the tuple
+func (tuple *Tuple) Enumerate() iter.Seq2[int, interface{}] {
+  return func(yield func(int, interface{}) bool) {
+    for i, item := range *tuple.l {
+      if !yield(i, item) {
+        return
+      }
+    }
+  }
+}
+
+// IterSequence A Sequence view of an iter.Seq. The items are produced by the iter.Seq each time the
+// sequence is traversed. Appended items are yielded after the ones of the iter.Seq
+type IterSequence struct {
+  seq iter.Seq[interface{}]
+}
+
+// FromSeq Return a Sequence view of seq, so that standard library iterators (maps.Keys,
+// slices.Values, ...) can be consumed by Map, Filter, Zip, etc.
+func FromSeq[T any](seq iter.Seq[T]) *IterSequence {
+  return &IterSequence{seq: func(yield func(interface{}) bool) {
+    for item := range seq {
+      if !yield(item) {
+        return
+      }
+    }
+  }}
+}
+
+func (s *IterSequence) Create(items ...interface{}) interface{} {
+  return FromSeq(slices.Values(items))
+}
+
+// Traverse the iter.Seq and execute operation on each item. It stops if operation returns false
+func (s *IterSequence) Traverse(operation func(interface{}) bool) bool {
+  return generic.FromSeq(s.seq).Traverse(operation)
+}
+
+// Append one or more items to be yielded after the current ones
+func (s *IterSequence) Append(item interface{}, items ...interface{}) interface{} {
+  prev, extra := s.seq, append([]interface{}{item}, items...)
+  s.seq = func(yield func(interface{}) bool) {
+    for i := range prev {
+      if !yield(i) {
+        return
+      }
+    }
+    for _, i := range extra {
+      if !yield(i) {
+        return
+      }
+    }
+  }
+  return s
+}
+
+// Size Return the number of items. The iter.Seq is completely ranged
+func (s *IterSequence) Size() int {
+  return generic.FromSeq(s.seq).Size()
+}
+
+// Swap in O(1) two sequences
+func (s *IterSequence) Swap(other interface{}) interface{} {
+  otherSeq := other.(*IterSequence)
+  s.seq, otherSeq.seq = otherSeq.seq, s.seq
+  return s
+}
+
+// IsEmpty Return true if the iter.Seq produces no items
+func (s *IterSequence) IsEmpty() bool {
+  return generic.FromSeq(s.seq).IsEmpty()
+}
+
+// CreateIterator Return an iterator to the sequence compliant with the interface Sequence. An iterator
+// abandoned before its end must be released by calling its Stop method
+func (s *IterSequence) CreateIterator() interface{} {
+  return &typedIterator[interface{}]{it: generic.FromSeq(s.seq).CreateIterator()}
+}
+
+// Seq Return the underlying iter.Seq
+func (s *IterSequence) Seq() iter.Seq[interface{}] {
+  return s.seq
+}
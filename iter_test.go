@@ -0,0 +1,93 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "maps"
+  "slices"
+  "sort"
+  "testing"
+)
+
+func TestValues(t *testing.T) {
+
+  i := 0
+  for item := range Values(createSet()) {
+    assert.Equal(t, item, i)
+    i++
+  }
+  assert.Equal(t, i, N)
+
+  var items []interface{}
+  for item := range Values(Seq.New(1, 2, 3, 4)) {
+    if item.(int) == 3 {
+      break
+    }
+    items = append(items, item)
+  }
+  assert.Equal(t, items, []interface{}{1, 2})
+}
+
+func TestIteratorValues(t *testing.T) {
+
+  it := NewTuple("a", "b", "c").CreateIterator().(SequentialIterator)
+  it.Next()
+  assert.Equal(t, slices.Collect(IteratorValues(it)), []interface{}{"a", "b", "c"})
+}
+
+func TestTuple_Enumerate(t *testing.T) {
+
+  tuple := NewTuple("a", "b", "c")
+  for i, item := range tuple.Enumerate() {
+    assert.Equal(t, item, tuple.Nth(i))
+  }
+}
+
+func TestFromSeq(t *testing.T) {
+
+  m := map[string]int{"one": 1, "two": 2, "three": 3}
+
+  keys := FromSeq(maps.Keys(m))
+  assert.Equal(t, keys.Size(), 3)
+  assert.False(t, keys.IsEmpty())
+
+  lengths := Map(keys, func(k interface{}) interface{} {
+    return len(k.(string))
+  })
+  sum := Foldl(lengths, 0, func(acu, item interface{}) interface{} {
+    return acu.(int) + item.(int)
+  })
+  assert.Equal(t, sum, 11)
+
+  values := FromSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+  values.Append(6, 7)
+  assert.Equal(t, Filter(values, func(i interface{}) bool {
+    return i.(int)%2 == 0
+  }).ToSlice(), []interface{}{2, 4, 6})
+
+  zl := Zip(values, FromSeq(slices.Values([]string{"a", "b"})))
+  assert.Equal(t, zl.ToSlice(), []interface{}{Pair{Item1: 1, Item2: "a"}, Pair{Item1: 2, Item2: "b"}})
+
+  sorted := slices.Collect(Values(Map(FromSeq(maps.Values(m)), func(i interface{}) interface{} {
+    return i
+  })))
+  sort.Slice(sorted, func(i, j int) bool { return sorted[i].(int) < sorted[j].(int) })
+  assert.Equal(t, sorted, []interface{}{1, 2, 3})
+
+  assert.True(t, FromSeq(slices.Values([]int{})).IsEmpty())
+}
+
+func TestFromSeqRelease(t *testing.T) {
+
+  running := 0
+  naturals := FromSeq(func(yield func(interface{}) bool) {
+    running++
+    defer func() { running-- }()
+    for i := 0; yield(i); i++ {
+    }
+  })
+
+  assert.Equal(t, Take(naturals, 2).ToSlice(), []interface{}{0, 1})
+  assert.Equal(t, Zip(naturals, NewTuple("a", "b")).Size(), 2)
+  assert.Equal(t, running, 0)
+}
@@ -0,0 +1,24 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// Merge Return a sequence with the items of the sorted sequences s1 and s2 sorted according to less. Of two
+// equivalent items, the one of s1 goes first
+func Merge(s1, s2 Sequence, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.Merge(generic.Of[interface{}](s1), generic.Of[interface{}](s2), less).Slist()
+}
+
+// KWayMerge Return a lazy stream with the items of the sorted sequences seqs sorted according to less. The
+// sequences are read as the stream is evaluated and equivalent items keep the order of seqs
+func KWayMerge(less func(i1, i2 interface{}) bool, seqs ...Sequence) *Stream {
+  return &Stream{s: generic.KWayMerge(less, dynamicSeqs(seqs)...)}
+}
+
+// MergeSort Return a list with the items of seq stably sorted according to less. The sorted runs of seq are
+// merged with KWayMerge
+func MergeSort(seq Sequence, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.MergeSort(generic.Of[interface{}](seq), less).Slist()
+}
@@ -0,0 +1,35 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "math/rand"
+  "testing"
+)
+
+func TestMerge(t *testing.T) {
+
+  assert.Equal(t, Merge(Seq.New(1, 4, 6), NewTuple(2, 3, 7, 8), cmpInt).ToSlice(),
+    []interface{}{1, 2, 3, 4, 6, 7, 8})
+  assert.Equal(t, Merge(Seq.New(), Seq.New(1), cmpInt).ToSlice(), []interface{}{1})
+  assert.Equal(t, Merge(createSet(), createSet(), cmpInt).Size(), 2*N)
+}
+
+func TestKWayMerge(t *testing.T) {
+
+  shards := []Sequence{Seq.New(1, 5, 9), Seq.New(), Seq.New(2, 6), NewTuple(0, 3, 4, 7, 8)}
+  merged := KWayMerge(cmpInt, shards...)
+  assert.Equal(t, merged.ToSlist().ToSlice(), []interface{}{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+  assert.Equal(t, merged.Take(3).ToSlist().ToSlice(), []interface{}{0, 1, 2})
+  assert.True(t, KWayMerge(cmpInt).IsEmpty())
+}
+
+func TestMergeSort(t *testing.T) {
+
+  l := Seq.New()
+  for _, i := range rand.Perm(N) {
+    l.Append(i)
+  }
+  assert.Equal(t, MergeSort(l, cmpInt).ToSlice(), Take(createSet(), N).ToSlice())
+  assert.True(t, MergeSort(Seq.New(), cmpInt).IsEmpty())
+}
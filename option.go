@@ -0,0 +1,54 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+)
+
+// Option An optional interface{}. Returned by SearchOpt, FindOpt and NthOpt
+type Option = generic.Option[interface{}]
+
+// Result The outcome of a fallible operation returning an interface{}
+type Result = generic.Result[interface{}]
+
+// Some Return an Option containing item, which may be nil
+func Some(item interface{}) Option {
+  return generic.Some(item)
+}
+
+// None Return an empty Option
+func None() Option {
+  return generic.None[interface{}]()
+}
+
+// Ok Return a successful Result containing item
+func Ok(item interface{}) Result {
+  return generic.Ok(item)
+}
+
+// Err Return a failed Result containing err
+func Err(err error) Result {
+  return generic.Err[interface{}](err)
+}
+
+// SearchOpt Return Some with the first item meeting predicate, None if no item meets it. Unlike Search, a
+// found nil item is distinguished from absence
+func SearchOpt(seq Sequence, predicate func(interface{}) bool) Option {
+  return generic.SearchOpt(generic.Of[interface{}](seq), predicate)
+}
+
+// FindOpt Return Some with the first item in seq satisfying predicate, None if no item satisfies it
+func FindOpt(seq Sequence, predicate func(item interface{}) bool) Option {
+  return generic.FindOpt(generic.Of[interface{}](seq), predicate)
+}
+
+// NthOpt Return Some with the n-th item in the sequence, None if n is negative or greater or equal than
+// seq.Size()
+func NthOpt(seq Sequence, n int) Option {
+  return generic.NthOpt(generic.Of[interface{}](seq), n)
+}
+
+// PositionOpt Return Some with the position of the first element satisfying predicate, None if no element
+// satisfies it
+func PositionOpt(seq Sequence, predicate func(item interface{}) bool) generic.Option[int] {
+  return generic.PositionOpt(generic.Of[interface{}](seq), predicate)
+}
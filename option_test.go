@@ -0,0 +1,49 @@
+package FunctionalLib
+
+import (
+  "errors"
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestSearchFindOpt(t *testing.T) {
+
+  l := Seq.New(1, nil, 3)
+
+  isNil := func(i interface{}) bool { return i == nil }
+  assert.Nil(t, Search(l, isNil)) // ambiguous
+  assert.True(t, SearchOpt(l, isNil).IsSome())
+  assert.True(t, FindOpt(l, isNil).IsSome())
+
+  assert.True(t, SearchOpt(l, func(i interface{}) bool { return i == 4 }).IsNone())
+  assert.Equal(t, FindOpt(l, func(i interface{}) bool { return i == 3 }).MustGet(), 3)
+  assert.Equal(t, FindOpt(l, func(i interface{}) bool { return i == 4 }).OrElse(-1), -1)
+}
+
+func TestNthPositionOpt(t *testing.T) {
+
+  l := Seq.New(1, nil, 3)
+
+  item, ok := NthOpt(l, 1).Get()
+  assert.True(t, ok)
+  assert.Nil(t, item)
+  assert.True(t, NthOpt(l, 3).IsNone())
+  assert.True(t, NthOpt(l, -1).IsNone())
+
+  assert.Equal(t, PositionOpt(l, func(i interface{}) bool { return i == 3 }).MustGet(), 2)
+  assert.True(t, PositionOpt(l, func(i interface{}) bool { return i == 4 }).IsNone())
+}
+
+func TestOptionResult(t *testing.T) {
+
+  assert.Equal(t, Some(nil).String(), "Some(<nil>)")
+  assert.Equal(t, None().String(), "None")
+  assert.Panics(t, func() { None().MustGet() })
+
+  assert.True(t, Ok(nil).IsOk())
+  err := errors.New("failure")
+  assert.Equal(t, Err(err).Error(), err)
+  assert.Equal(t, Err(err).OrElse(5), 5)
+  assert.True(t, Err(err).ToOption().IsNone())
+}
@@ -0,0 +1,27 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// ParallelMap Return a new seq with the items of sequence transformed with transformation. The transformations
+// are executed by a pool of workers goroutines (GOMAXPROCS if workers <= 0) but the result preserves the order
+// of seq. transformation must be safe for concurrent use
+func ParallelMap(seq Sequence, workers int, transformation func(interface{}) interface{}) *Seq.Slist {
+  return generic.ParallelMap(generic.Of[interface{}](seq), workers, transformation).Slist()
+}
+
+// ParallelFilter Return a list containing the items satisfying predicate in the same order of seq. The predicate
+// is evaluated by a pool of workers goroutines (GOMAXPROCS if workers <= 0) and must be safe for concurrent use
+func ParallelFilter(seq Sequence, workers int, predicate func(interface{}) bool) *Seq.Slist {
+  return generic.ParallelFilter(generic.Of[interface{}](seq), workers, predicate).Slist()
+}
+
+// ParallelFoldl Fold concurrently workers contiguous chunks of seq with f starting from initVal and merge the
+// partial results from left to right with combine, which must be associative and have initVal as identity
+func ParallelFoldl(seq Sequence, workers int, initVal interface{},
+  f func(acu, item interface{}) interface{}, combine func(acu1, acu2 interface{}) interface{}) interface{} {
+
+  return generic.ParallelFoldl(generic.Of[interface{}](seq), workers, initVal, f, combine)
+}
@@ -0,0 +1,53 @@
+package FunctionalLib
+
+import (
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestParallelMap(t *testing.T) {
+
+  for _, workers := range []int{0, 1, 3, N, 2 * N} {
+    m := ParallelMap(createSet(), workers, func(i interface{}) interface{} {
+      return 2 * i.(int)
+    })
+
+    assert.Equal(t, m.Size(), N)
+    assert.True(t, All(Zip(createSet(), m), func(i interface{}) bool {
+      p := i.(Pair)
+      return 2*p.Item1.(int) == p.Item2.(int)
+    }))
+  }
+}
+
+func TestParallelFilter(t *testing.T) {
+
+  pred := func(i interface{}) bool {
+    return i.(int)%3 == 0
+  }
+
+  for _, workers := range []int{0, 1, 4} {
+    assert.Equal(t, ParallelFilter(createSet(), workers, pred).ToSlice(), Filter(createSet(), pred).ToSlice())
+  }
+}
+
+func TestParallelFoldl(t *testing.T) {
+
+  add := func(acu, item interface{}) interface{} {
+    return acu.(int) + item.(int)
+  }
+
+  for _, workers := range []int{0, 1, 7, N} {
+    assert.Equal(t, ParallelFoldl(createSet(), workers, 0, add, add).(int), N*(N-1)/2)
+  }
+
+  // string concatenation is associative but not commutative: the order of the chunks must be kept
+  concat := ParallelFoldl(NewTuple("a", "b", "c", "d", "e"), 3, "", func(acu, item interface{}) interface{} {
+    return acu.(string) + item.(string)
+  }, func(acu1, acu2 interface{}) interface{} {
+    return acu1.(string) + acu2.(string)
+  })
+  assert.Equal(t, concat, "abcde")
+
+  assert.Equal(t, ParallelFoldl(NewTuple(), 4, 10, add, add), 10)
+}
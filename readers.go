@@ -0,0 +1,118 @@
+package FunctionalLib
+
+import (
+  "encoding/csv"
+  "github.com/lrleon/FunctionalLib/generic"
+  "io"
+  "strings"
+)
+
+// ReaderSeq A lazy Sequence over the items read from an io.Reader. It is also its own SequentialIterator, so
+// the reader is consumed only once unless it is an io.Seeker, in which case ResetFirst reads it again. The
+// iteration ends at the end of the reader or at the first error, which is then returned by Err
+type ReaderSeq struct {
+  s *generic.ReaderSeq[interface{}]
+}
+
+func box[T any](item T) interface{} {
+  return item
+}
+
+// LinesOf Return a lazy sequence over the lines, as string, of r
+func LinesOf(r io.Reader) *ReaderSeq {
+  return &ReaderSeq{s: generic.MapReader(generic.LinesOf(r), box[string])}
+}
+
+// CSVRecords Return a lazy sequence over the records of the CSV data of r. Each record is a *Tuple with its
+// fields as string. The default options of csv.Reader are used
+func CSVRecords(r io.Reader) *ReaderSeq {
+  return CSVRecordsWith(r, nil)
+}
+
+// CSVRecordsWith Return a lazy sequence over the records of the CSV data of r as CSVRecords does. configure,
+// if not nil, is called for setting the options of the csv.Reader
+func CSVRecordsWith(r io.Reader, configure func(*csv.Reader)) *ReaderSeq {
+  return &ReaderSeq{s: generic.MapReader(generic.CSVRecordsWith(r, configure),
+    func(record *generic.Tuple[string]) interface{} {
+      ret := BuildTuple(record.Size())
+      for i, field := range record.Enumerate() {
+        ret.Set(i, field)
+      }
+      return ret
+    })}
+}
+
+// JSONLinesOf Return a lazy sequence over the items decoded with decode from every non blank line of r. A
+// decoding error stops the iteration and is returned by Err
+func JSONLinesOf(r io.Reader, decode func(line []byte) (interface{}, error)) *ReaderSeq {
+  return &ReaderSeq{s: generic.JSONLinesOf(r, decode)}
+}
+
+// Err Return the first error found while reading, or nil if the iteration ended at the end of the reader
+func (seq *ReaderSeq) Err() error {
+  return seq.s.Err()
+}
+
+// Create Return a sequence over no reader with the received items
+func (seq *ReaderSeq) Create(items ...interface{}) interface{} {
+  ret := LinesOf(strings.NewReader(""))
+  if len(items) > 0 {
+    ret.Append(items[0], items[1:]...)
+  }
+  return ret
+}
+
+// Traverse the remaining items and execute operation on each one. It stops if operation returns false
+func (seq *ReaderSeq) Traverse(operation func(interface{}) bool) bool {
+  return seq.s.Traverse(operation)
+}
+
+// Append one or more items to be yielded after the ones of the reader
+func (seq *ReaderSeq) Append(item interface{}, items ...interface{}) interface{} {
+  seq.s.Append(item, items...)
+  return seq
+}
+
+// Size Read all the remaining items and return how many they are
+func (seq *ReaderSeq) Size() int {
+  return seq.s.Size()
+}
+
+// Swap in O(1) two sequences
+func (seq *ReaderSeq) Swap(other interface{}) interface{} {
+  otherSeq := other.(*ReaderSeq)
+  seq.s, otherSeq.s = otherSeq.s, seq.s
+  return seq
+}
+
+// IsEmpty Return true if there are no remaining items
+func (seq *ReaderSeq) IsEmpty() bool {
+  return seq.s.IsEmpty()
+}
+
+// CreateIterator Return the sequence itself, which is its own iterator
+func (seq *ReaderSeq) CreateIterator() interface{} {
+  return seq
+}
+
+// ResetFirst Restart the iteration from the first item if the reader is an io.Seeker
+func (seq *ReaderSeq) ResetFirst() interface{} {
+  seq.s.ResetFirst()
+  return seq
+}
+
+// HasCurr Return true if the iterator is on an item
+func (seq *ReaderSeq) HasCurr() bool {
+  return seq.s.HasCurr()
+}
+
+// GetCurr Return the current item
+func (seq *ReaderSeq) GetCurr() interface{} {
+  return seq.s.GetCurr()
+}
+
+// Next Advance to the next item
+func (seq *ReaderSeq) Next() interface{} {
+  seq.s.Next()
+  return seq
+}
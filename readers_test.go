@@ -0,0 +1,70 @@
+package FunctionalLib
+
+import (
+  "encoding/csv"
+  "encoding/json"
+  "github.com/stretchr/testify/assert"
+  "strings"
+  "testing"
+)
+
+func TestLinesOf(t *testing.T) {
+
+  lines := LinesOf(strings.NewReader("alpha\nbeta\ngamma\n"))
+  long := Filter(lines, func(l interface{}) bool { return len(l.(string)) > 4 })
+  assert.Equal(t, long.ToSlice(), []interface{}{"alpha", "gamma"})
+  assert.NoError(t, lines.Err())
+
+  // the reader is seekable, so it can be read again
+  lines.ResetFirst()
+  assert.Equal(t, ToSlice(lines), []interface{}{"alpha", "beta", "gamma"})
+
+  it := LinesOf(strings.NewReader("x\ny")).CreateIterator().(SequentialIterator)
+  assert.Equal(t, it.Next().(SequentialIterator).GetCurr(), "y")
+}
+
+func TestReaderSeq_Nth(t *testing.T) {
+
+  assert.Equal(t, Nth(LinesOf(strings.NewReader("a\nb\nc\n")), 1), "b")
+  assert.Nil(t, Nth(LinesOf(strings.NewReader("a\nb\nc\n")), 3))
+
+  lines := LinesOf(strings.NewReader("a\nb\nc\n"))
+  assert.Equal(t, NthOpt(lines, 0).MustGet(), "a")
+  assert.Equal(t, ToSlice(lines), []interface{}{"b", "c"}) // the remaining lines were not read
+  assert.False(t, NthOpt(LinesOf(strings.NewReader("")), 0).IsSome())
+}
+
+func TestCSVRecords(t *testing.T) {
+
+  records := CSVRecords(strings.NewReader("a,1\nb,2\nc,3\n"))
+  total := Foldl(records, "", func(acu, r interface{}) interface{} {
+    return acu.(string) + r.(*Tuple).Nth(0).(string) + r.(*Tuple).Nth(1).(string)
+  })
+  assert.Equal(t, total, "a1b2c3")
+  assert.NoError(t, records.Err())
+
+  semicolons := CSVRecordsWith(strings.NewReader("a;1\n"), func(r *csv.Reader) { r.Comma = ';' })
+  assert.Equal(t, ToSlice(semicolons), []interface{}{NewTuple("a", "1")})
+
+  bad := CSVRecords(strings.NewReader("a,\"b\nc"))
+  assert.True(t, bad.IsEmpty())
+  assert.Error(t, bad.Err())
+}
+
+func TestJSONLinesOf(t *testing.T) {
+
+  decode := func(line []byte) (interface{}, error) {
+    var m map[string]interface{}
+    err := json.Unmarshal(line, &m)
+    return m, err
+  }
+
+  objects := JSONLinesOf(strings.NewReader("{\"id\": 1}\n{\"id\": 2}\n"), decode)
+  ids := Map(objects, func(o interface{}) interface{} { return o.(map[string]interface{})["id"] })
+  assert.Equal(t, ids.ToSlice(), []interface{}{1.0, 2.0})
+  assert.NoError(t, objects.Err())
+
+  broken := JSONLinesOf(strings.NewReader("{\"id\": 1}\n[\n"), decode)
+  assert.Equal(t, broken.Size(), 1)
+  assert.Error(t, broken.Err())
+}
@@ -0,0 +1,38 @@
+package FunctionalLib
+
+// LowerBound Return the first position of the tuple, sorted according to less, whose item is not less than
+// item. It returns Size() if every item is less than item. Complexity O(log n)
+func (tuple *Tuple) LowerBound(item interface{}, less func(i1, i2 interface{}) bool) int {
+  return tuple.view().LowerBound(item, less)
+}
+
+// UpperBound Return the first position of the tuple, sorted according to less, whose item is greater than
+// item. It returns Size() if no item is greater than item. Complexity O(log n)
+func (tuple *Tuple) UpperBound(item interface{}, less func(i1, i2 interface{}) bool) int {
+  return tuple.view().UpperBound(item, less)
+}
+
+// EqualRange Return the interval [first, last) of positions of the tuple, sorted according to less, whose
+// items are equal to item. The interval is empty if item is not in the tuple
+func (tuple *Tuple) EqualRange(item interface{}, less func(i1, i2 interface{}) bool) (first, last int) {
+  return tuple.view().EqualRange(item, less)
+}
+
+// BinarySearch Search item in the tuple sorted according to less. Return the position of the first item equal
+// to item and true, or the position where item would be inserted and false
+func (tuple *Tuple) BinarySearch(item interface{}, less func(i1, i2 interface{}) bool) (int, bool) {
+  return tuple.view().BinarySearch(item, less)
+}
+
+// InsertSorted Insert item in the tuple sorted according to less keeping it sorted. The item is placed after
+// the items equal to it
+func (tuple *Tuple) InsertSorted(item interface{}, less func(i1, i2 interface{}) bool) *Tuple {
+  tuple.view().InsertSorted(item, less)
+  return tuple
+}
+
+// InsertSortedUnique Insert item in the tuple sorted according to less only if no item equal to it is already
+// present, so that the tuple can be used as a sorted set. Return true if item was inserted
+func (tuple *Tuple) InsertSortedUnique(item interface{}, less func(i1, i2 interface{}) bool) bool {
+  return tuple.view().InsertSortedUnique(item, less)
+}
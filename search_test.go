@@ -0,0 +1,74 @@
+package FunctionalLib
+
+import (
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestTuple_Bounds(t *testing.T) {
+
+  tuple := NewTuple(1, 2, 2, 2, 5, 7)
+
+  assert.Equal(t, tuple.LowerBound(2, cmpInt), 1)
+  assert.Equal(t, tuple.UpperBound(2, cmpInt), 4)
+  assert.Equal(t, tuple.LowerBound(0, cmpInt), 0)
+  assert.Equal(t, tuple.UpperBound(7, cmpInt), 6)
+  assert.Equal(t, tuple.LowerBound(8, cmpInt), 6)
+
+  first, last := tuple.EqualRange(2, cmpInt)
+  assert.Equal(t, []int{first, last}, []int{1, 4})
+  first, last = tuple.EqualRange(3, cmpInt)
+  assert.Equal(t, []int{first, last}, []int{4, 4})
+}
+
+func TestTuple_BinarySearch(t *testing.T) {
+
+  tuple := BuildTuple(N)
+  for i := 0; i < N; i++ {
+    tuple.Set(i, 2*i)
+  }
+
+  for i := 0; i < N; i++ {
+    pos, found := tuple.BinarySearch(2*i, cmpInt)
+    assert.True(t, found)
+    assert.Equal(t, pos, i)
+
+    pos, found = tuple.BinarySearch(2*i+1, cmpInt)
+    assert.False(t, found)
+    assert.Equal(t, pos, i+1)
+  }
+
+  _, found := NewTuple().BinarySearch(1, cmpInt)
+  assert.False(t, found)
+}
+
+func TestTuple_InsertSorted(t *testing.T) {
+
+  tuple := NewTuple()
+  for _, i := range []int{5, 1, 4, 1, 3, 9, 2, 6} {
+    tuple.InsertSorted(i, cmpInt)
+  }
+  assert.Equal(t, tuple.Size(), 8)
+  assert.True(t, IsSorted(tuple, cmpInt))
+
+  set := NewTuple()
+  for _, i := range []int{5, 1, 4, 1, 5, 9, 2, 6} {
+    set.InsertSortedUnique(i, cmpInt)
+  }
+  assert.Equal(t, Map(set, func(i interface{}) interface{} { return i }).ToSlice(),
+    []interface{}{1, 2, 4, 5, 6, 9})
+  assert.False(t, set.InsertSortedUnique(9, cmpInt))
+  assert.True(t, set.InsertSortedUnique(3, cmpInt))
+  assert.Equal(t, set.Nth(2), 3)
+}
+
+func TestTuple_SearchZero(t *testing.T) {
+
+  var zero Tuple
+  assert.Equal(t, zero.LowerBound(1, cmpInt), 0)
+  _, found := zero.BinarySearch(1, cmpInt)
+  assert.False(t, found)
+  zero.InsertSorted(2, cmpInt).InsertSorted(1, cmpInt)
+  assert.Equal(t, zero.Size(), 2)
+  assert.Equal(t, zero.Nth(0), 1)
+}
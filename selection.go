@@ -0,0 +1,41 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// NthElementInterval Rearrange in place the subsequence in [i, j] so that the item at position n is the one
+// that would be there if [i, j] were sorted according to less, the items in [i, n) are not greater than it
+// and the items in (n, j] are not less than it. Expected time is O(j - i)
+func (tuple *Tuple) NthElementInterval(i, j, n int, less func(i1, i2 interface{}) bool) *Tuple {
+  tuple.view().NthElementInterval(i, j, n, less)
+  return tuple
+}
+
+// NthElement Rearrange in place the tuple so that the item at position n is the one that would be there if
+// the tuple were sorted. For example, NthElement(Size() / 2, less) places the median at the middle. It panics
+// if n is not a position of the tuple
+func (tuple *Tuple) NthElement(n int, less func(i1, i2 interface{}) bool) *Tuple {
+  tuple.view().NthElement(n, less)
+  return tuple
+}
+
+// PartialSortInterval Rearrange in place the subsequence in [i, j] so that its k smallest items according to
+// less are sorted in [i, i + k). The order of the rest of items is unspecified
+func (tuple *Tuple) PartialSortInterval(i, j, k int, less func(i1, i2 interface{}) bool) *Tuple {
+  tuple.view().PartialSortInterval(i, j, k, less)
+  return tuple
+}
+
+// PartialSort Rearrange in place the tuple so that its k smallest items are sorted at its beginning
+func (tuple *Tuple) PartialSort(k int, less func(i1, i2 interface{}) bool) *Tuple {
+  tuple.view().PartialSort(k, less)
+  return tuple
+}
+
+// TopK Return a sorted list with the k smallest items of seq according to less. Pass a greater comparator to
+// get the k greatest ones. It takes O(n log k) time and O(k) space
+func TopK(seq Sequence, k int, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.TopK(generic.Of[interface{}](seq), k, less).Slist()
+}
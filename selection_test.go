@@ -0,0 +1,77 @@
+package FunctionalLib
+
+import (
+  "github.com/stretchr/testify/assert"
+  "math/rand"
+  "testing"
+)
+
+func shuffledTuple(n int) *Tuple {
+  tuple := BuildTuple(n)
+  for i, p := range rand.New(rand.NewSource(17)).Perm(n) {
+    tuple.Set(i, p)
+  }
+  return tuple
+}
+
+func TestTuple_NthElement(t *testing.T) {
+
+  for n := 0; n < N; n += 7 {
+    tuple := shuffledTuple(N)
+    tuple.NthElement(n, cmpInt)
+    assert.Equal(t, tuple.Nth(n), n)
+    for i := 0; i < N; i++ {
+      if i < n {
+        assert.Less(t, tuple.Nth(i).(int), n)
+      } else if i > n {
+        assert.Greater(t, tuple.Nth(i).(int), n)
+      }
+    }
+  }
+
+  dups := NewTuple(3, 1, 3, 3, 2, 3, 1, 3)
+  assert.Equal(t, dups.NthElement(4, cmpInt).Nth(4), 3)
+  assert.Equal(t, dups.NthElement(1, cmpInt).Nth(1), 1)
+}
+
+func TestTuple_NthElementInterval(t *testing.T) {
+
+  tuple := NewTuple(9, 8, 7, 6, 5, 4, 3, 2, 1, 0)
+  tuple.NthElementInterval(2, 6, 3, cmpInt)
+  assert.Equal(t, tuple.Nth(3), 4)
+  assert.Equal(t, Take(tuple, 2).ToSlice(), []interface{}{9, 8})
+  assert.Equal(t, Drop(tuple, 7).ToSlice(), []interface{}{2, 1, 0})
+
+  assert.Panics(t, func() { tuple.NthElementInterval(2, 6, 7, cmpInt) })
+  assert.Panics(t, func() { tuple.NthElementInterval(6, 2, 3, cmpInt) })
+  assert.Panics(t, func() { tuple.NthElementInterval(-1, 2, 0, cmpInt) })
+}
+
+func TestTuple_PartialSort(t *testing.T) {
+
+  tuple := shuffledTuple(N)
+  tuple.PartialSort(10, cmpInt)
+  assert.Equal(t, Take(tuple, 10).ToSlice(), Take(createSet(), 10).ToSlice())
+
+  tuple = NewTuple(9, 8, 7, 6, 5, 4, 3, 2, 1, 0)
+  tuple.PartialSortInterval(1, 8, 3, cmpInt)
+  assert.Equal(t, Take(tuple, 4).ToSlice(), []interface{}{9, 1, 2, 3})
+  assert.Equal(t, tuple.Nth(9), 0)
+
+  assert.Panics(t, func() { tuple.PartialSortInterval(1, 8, 9, cmpInt) })
+  assert.Equal(t, tuple.PartialSort(0, cmpInt).Nth(0), 9)
+  assert.True(t, NewTuple().PartialSort(0, cmpInt).IsEmpty())
+  assert.Panics(t, func() { NewTuple().NthElement(0, cmpInt) })
+}
+
+func TestTopK(t *testing.T) {
+
+  tuple := shuffledTuple(N)
+  assert.Equal(t, TopK(tuple, 5, cmpInt).ToSlice(), []interface{}{0, 1, 2, 3, 4})
+  assert.Equal(t, TopK(tuple, 3, func(i1, i2 interface{}) bool {
+    return cmpInt(i2, i1)
+  }).ToSlice(), []interface{}{N - 1, N - 2, N - 3})
+
+  assert.Equal(t, TopK(NewTuple(2, 1), 5, cmpInt).ToSlice(), []interface{}{1, 2})
+  assert.True(t, TopK(tuple, 0, cmpInt).IsEmpty())
+}
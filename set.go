@@ -0,0 +1,66 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// Distinct Return a sequence with the first occurrence of every item of seq, in the order of seq. The items
+// must be comparable
+func Distinct(seq Sequence) *Seq.Slist {
+  return generic.Distinct(generic.Of[interface{}](seq)).Slist()
+}
+
+// DistinctBy Return a sequence with the items of seq whose key, which must be comparable, has not been seen
+// before. The order of seq is preserved
+func DistinctBy(seq Sequence, key func(interface{}) interface{}) *Seq.Slist {
+  return generic.DistinctBy(generic.Of[interface{}](seq), key).Slist()
+}
+
+// Union Return a sequence with the distinct items of s1 followed by the distinct items of s2 not in s1
+func Union(s1, s2 Sequence) *Seq.Slist {
+  return generic.Union(generic.Of[interface{}](s1), generic.Of[interface{}](s2)).Slist()
+}
+
+// Intersect Return a sequence with the distinct items of s1 that are also in s2, in the order of s1
+func Intersect(s1, s2 Sequence) *Seq.Slist {
+  return generic.Intersect(generic.Of[interface{}](s1), generic.Of[interface{}](s2)).Slist()
+}
+
+// Difference Return a sequence with the distinct items of s1 that are not in s2, in the order of s1
+func Difference(s1, s2 Sequence) *Seq.Slist {
+  return generic.Difference(generic.Of[interface{}](s1), generic.Of[interface{}](s2)).Slist()
+}
+
+// SymmetricDifference Return a sequence with the distinct items of s1 not in s2 followed by the distinct items
+// of s2 not in s1
+func SymmetricDifference(s1, s2 Sequence) *Seq.Slist {
+  return generic.SymmetricDifference(generic.Of[interface{}](s1), generic.Of[interface{}](s2)).Slist()
+}
+
+// DistinctSorted Return a sequence with the items of seq sorted according to less and without equivalent items
+func DistinctSorted(seq Sequence, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.DistinctSorted(generic.Of[interface{}](seq), less).Slist()
+}
+
+// UnionSorted As Union but the items are compared with less and the result is sorted according to less
+func UnionSorted(s1, s2 Sequence, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.UnionSorted(generic.Of[interface{}](s1), generic.Of[interface{}](s2), less).Slist()
+}
+
+// IntersectSorted As Intersect but the items are compared with less and the result is sorted according to less
+func IntersectSorted(s1, s2 Sequence, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.IntersectSorted(generic.Of[interface{}](s1), generic.Of[interface{}](s2), less).Slist()
+}
+
+// DifferenceSorted As Difference but the items are compared with less and the result is sorted according to
+// less
+func DifferenceSorted(s1, s2 Sequence, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.DifferenceSorted(generic.Of[interface{}](s1), generic.Of[interface{}](s2), less).Slist()
+}
+
+// SymmetricDifferenceSorted As SymmetricDifference but the items are compared with less and the result is
+// sorted according to less
+func SymmetricDifferenceSorted(s1, s2 Sequence, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.SymmetricDifferenceSorted(generic.Of[interface{}](s1), generic.Of[interface{}](s2), less).Slist()
+}
@@ -0,0 +1,50 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "strings"
+  "testing"
+)
+
+func TestDistinct(t *testing.T) {
+
+  assert.Equal(t, Distinct(Seq.New(3, 1, 3, 2, 1)).ToSlice(), []interface{}{3, 1, 2})
+  assert.Equal(t, Distinct(createSet()).Size(), N)
+  assert.True(t, Distinct(Seq.New()).IsEmpty())
+
+  words := Seq.New("Go", "is", "GO", "fun", "IS")
+  assert.Equal(t, DistinctBy(words, func(w interface{}) interface{} {
+    return strings.ToLower(w.(string))
+  }).ToSlice(), []interface{}{"Go", "is", "fun"})
+}
+
+func TestSetOperations(t *testing.T) {
+
+  s1, s2 := Seq.New(5, 1, 3, 1, 7), NewTuple(3, 9, 5, 9)
+  assert.Equal(t, Union(s1, s2).ToSlice(), []interface{}{5, 1, 3, 7, 9})
+  assert.Equal(t, Intersect(s1, s2).ToSlice(), []interface{}{5, 3})
+  assert.Equal(t, Difference(s1, s2).ToSlice(), []interface{}{1, 7})
+  assert.Equal(t, SymmetricDifference(s1, s2).ToSlice(), []interface{}{1, 7, 9})
+
+  assert.Equal(t, Intersect(createSet(), Take(createSet(), 10)).Size(), 10)
+  assert.True(t, Difference(s1, s1).IsEmpty())
+}
+
+func TestSortedSetOperations(t *testing.T) {
+
+  s1, s2 := Seq.New(5, 1, 3, 1, 7), NewTuple(3, 9, 5, 9)
+  assert.Equal(t, DistinctSorted(s1, cmpInt).ToSlice(), []interface{}{1, 3, 5, 7})
+  assert.Equal(t, UnionSorted(s1, s2, cmpInt).ToSlice(), []interface{}{1, 3, 5, 7, 9})
+  assert.Equal(t, IntersectSorted(s1, s2, cmpInt).ToSlice(), []interface{}{3, 5})
+  assert.Equal(t, DifferenceSorted(s1, s2, cmpInt).ToSlice(), []interface{}{1, 7})
+  assert.Equal(t, SymmetricDifferenceSorted(s1, s2, cmpInt).ToSlice(), []interface{}{1, 7, 9})
+
+  // slices are not comparable, but they can be compared with a custom less
+  byLen := func(i1, i2 interface{}) bool {
+    return len(i1.([]int)) < len(i2.([]int))
+  }
+  l := Seq.New([]int{1, 2}, []int{3}, []int{4, 5})
+  assert.Equal(t, DistinctSorted(l, byLen).ToSlice(), []interface{}{[]int{3}, []int{1, 2}})
+  assert.Panics(t, func() { Distinct(l) })
+}
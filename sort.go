@@ -0,0 +1,51 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// SortInPlace Sort in place the tuple according to less. The sort is not stable
+func (tuple *Tuple) SortInPlace(less func(i1, i2 interface{}) bool) *Tuple {
+  tuple.view().SortInPlace(less)
+  return tuple
+}
+
+// Sort Return a sorted copy of tuple. The sort is not stable
+func (tuple *Tuple) Sort(less func(i1, i2 interface{}) bool) *Tuple {
+  return tuple.Clone().SortInPlace(less)
+}
+
+// StableSortInPlace Sort in place the tuple according to less keeping the relative order of equal items
+func (tuple *Tuple) StableSortInPlace(less func(i1, i2 interface{}) bool) *Tuple {
+  tuple.view().StableSortInPlace(less)
+  return tuple
+}
+
+// StableSort Return a sorted copy of tuple keeping the relative order of equal items
+func (tuple *Tuple) StableSort(less func(i1, i2 interface{}) bool) *Tuple {
+  return tuple.Clone().StableSortInPlace(less)
+}
+
+// SortByInPlace Stable sort in place of the tuple according to the keys extracted with key and compared with
+// less. key is called once per item
+func (tuple *Tuple) SortByInPlace(key func(interface{}) interface{}, less func(k1, k2 interface{}) bool) *Tuple {
+  generic.SortByInPlace(tuple.view(), key, less)
+  return tuple
+}
+
+// SortBy Return a list with the items of seq stably sorted according to the keys extracted with key and
+// compared with less
+func SortBy(seq Sequence, key func(interface{}) interface{}, less func(k1, k2 interface{}) bool) *Seq.Slist {
+  return generic.SortBy(generic.Of[interface{}](seq), key, less).Slist()
+}
+
+// Sorted Return a list with the items of seq stably sorted according to less
+func Sorted(seq Sequence, less func(i1, i2 interface{}) bool) *Seq.Slist {
+  return generic.Sorted(generic.Of[interface{}](seq), less).Slist()
+}
+
+// IsSorted Return true if every item of seq is not less than its predecessor according to less
+func IsSorted(seq Sequence, less func(i1, i2 interface{}) bool) bool {
+  return generic.IsSorted(generic.Of[interface{}](seq), less)
+}
@@ -0,0 +1,64 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestTuple_SortInPlace(t *testing.T) {
+
+  tuple := NewTuple(5, 3, 9, 0, 1, 8, 2, 7, 4, 6)
+  sorted := tuple.Sort(cmpInt)
+  assert.True(t, IsSorted(sorted, cmpInt))
+  assert.False(t, IsSorted(tuple, cmpInt))
+
+  tuple.SortInPlace(cmpInt)
+  for i := 0; i < tuple.Size(); i++ {
+    assert.Equal(t, tuple.Nth(i), i)
+  }
+}
+
+func TestTuple_StableSort(t *testing.T) {
+
+  byFirst := func(i1, i2 interface{}) bool {
+    return i1.(Pair).Item1.(int) < i2.(Pair).Item1.(int)
+  }
+
+  tuple := NewTuple(Pair{Item1: 2, Item2: "a"}, Pair{Item1: 1, Item2: "b"}, Pair{Item1: 2, Item2: "c"}, Pair{Item1: 1, Item2: "d"}, Pair{Item1: 0, Item2: "e"})
+  sorted := tuple.StableSort(byFirst)
+  assert.Equal(t, Map(sorted, func(p interface{}) interface{} {
+    return p.(Pair).Item2
+  }).ToSlice(), []interface{}{"e", "b", "d", "a", "c"})
+  assert.Equal(t, tuple.Nth(0), Pair{Item1: 2, Item2: "a"})
+
+  tuple.StableSortInPlace(byFirst)
+  assert.True(t, All(Zip(tuple, sorted), func(p interface{}) bool {
+    return p.(Pair).Item1 == p.(Pair).Item2
+  }))
+}
+
+func TestSortBy(t *testing.T) {
+
+  length := func(s interface{}) interface{} {
+    return len(s.(string))
+  }
+
+  words := NewTuple("ccc", "a", "bb", "dd", "e")
+  assert.Equal(t, SortBy(words, length, cmpInt).ToSlice(), []interface{}{"a", "e", "bb", "dd", "ccc"})
+
+  words.SortByInPlace(length, cmpInt)
+  assert.Equal(t, words.Nth(4), "ccc")
+  assert.True(t, IsSorted(Map(words, length), cmpInt))
+}
+
+func TestSorted(t *testing.T) {
+
+  l := Seq.New(3, 1, 2)
+  assert.Equal(t, Sorted(l, cmpInt).ToSlice(), []interface{}{1, 2, 3})
+  assert.Equal(t, l.ToSlice(), []interface{}{3, 1, 2})
+
+  assert.True(t, IsSorted(createSet(), cmpInt))
+  assert.True(t, IsSorted(Seq.New(), cmpInt))
+  assert.True(t, IsSorted(Seq.New(1, 1, 1), cmpInt))
+}
@@ -0,0 +1,143 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// Stream A lazy pipeline over a sequence. Map, MapIf, Filter, Take and Drop only compose the
+// pipeline; the items are evaluated, one at a time and without intermediate lists, when a terminal
+// operation (ToSlist, Foldl, ForEach, Find, Exist, All) is executed. Stream implements Sequence, so it may
+// be passed to any combinator, which is then the terminal operation
+type Stream struct {
+  s *generic.Stream[interface{}]
+}
+
+// NewStream Return a lazy stream over the items of seq
+func NewStream(seq Sequence) *Stream {
+  return &Stream{s: generic.NewStream(generic.Of[interface{}](seq))}
+}
+
+// tupleStream Return a stream of *Tuple with the items of the generic tuples of stream
+func tupleStream(stream *generic.Stream[*generic.Tuple[interface{}]]) *Stream {
+  return &Stream{s: generic.MapStream(stream, func(t *generic.Tuple[interface{}]) interface{} {
+    items := t.ToSlice()
+    return &Tuple{l: &items}
+  })}
+}
+
+// Map Return a stream with the items transformed with transformation
+func (stream *Stream) Map(transformation func(interface{}) interface{}) *Stream {
+  return &Stream{s: generic.MapStream(stream.s, transformation)}
+}
+
+// MapIf Return a stream with the items satisfying predicate transformed with transformation
+func (stream *Stream) MapIf(transformation func(interface{}) interface{},
+  predicate func(interface{}) bool) *Stream {
+
+  return &Stream{s: generic.MapIfStream(stream.s, transformation, predicate)}
+}
+
+// Filter Return a stream containing the items satisfying predicate
+func (stream *Stream) Filter(predicate func(interface{}) bool) *Stream {
+  return &Stream{s: stream.s.Filter(predicate)}
+}
+
+// Take Return a stream containing the first n items. The source is not read beyond the n-th item
+func (stream *Stream) Take(n int) *Stream {
+  return &Stream{s: stream.s.Take(n)}
+}
+
+// Drop Return a stream containing the items after the first n
+func (stream *Stream) Drop(n int) *Stream {
+  return &Stream{s: stream.s.Drop(n)}
+}
+
+// TakeWhile Return a stream containing the longest prefix whose items satisfy predicate
+func (stream *Stream) TakeWhile(predicate func(interface{}) bool) *Stream {
+  return &Stream{s: stream.s.TakeWhile(predicate)}
+}
+
+// DropWhile Return a stream containing the items after the longest prefix whose items satisfy predicate
+func (stream *Stream) DropWhile(predicate func(interface{}) bool) *Stream {
+  return &Stream{s: stream.s.DropWhile(predicate)}
+}
+
+// Traverse Evaluate the stream and execute operation on each item. It stops if operation returns false
+func (stream *Stream) Traverse(operation func(interface{}) bool) bool {
+  return stream.s.Traverse(operation)
+}
+
+// Size Evaluate the stream and return its number of items
+func (stream *Stream) Size() int {
+  return stream.s.Size()
+}
+
+// IsEmpty Return true if the stream has no items. Only the first item is evaluated
+func (stream *Stream) IsEmpty() bool {
+  return stream.s.IsEmpty()
+}
+
+// CreateIterator Return an iterator compliant with SequentialIterator that evaluates the stream on demand
+func (stream *Stream) CreateIterator() interface{} {
+  return &typedIterator[interface{}]{it: stream.s.CreateIterator()}
+}
+
+// Create Return a new stream over items
+func (stream *Stream) Create(items ...interface{}) interface{} {
+  return &Stream{s: generic.NewStream[interface{}](generic.NewTuple(items...))}
+}
+
+// Append one or more items to be yielded after the current ones. Nothing is evaluated
+func (stream *Stream) Append(item interface{}, items ...interface{}) interface{} {
+  parts := generic.NewTuple[generic.Sequence[interface{}]](stream.s,
+    generic.NewTuple(append([]interface{}{item}, items...)...))
+  stream.s = generic.FlatMapStream(generic.NewStream[generic.Sequence[interface{}]](parts),
+    func(part generic.Sequence[interface{}]) generic.Sequence[interface{}] {
+      return part
+    })
+  return stream
+}
+
+// Swap in O(1) two streams
+func (stream *Stream) Swap(other interface{}) interface{} {
+  otherStream := other.(*Stream)
+  stream.s, otherStream.s = otherStream.s, stream.s
+  return stream
+}
+
+// ToSlist Evaluate the stream and return a list with its items
+func (stream *Stream) ToSlist() *Seq.Slist {
+  return stream.s.ToList().Slist()
+}
+
+// Foldl Evaluate the stream and return f(in, ..., f(i2, f(i1, initVal) ... ))
+func (stream *Stream) Foldl(initVal interface{}, f func(acu, item interface{}) interface{}) interface{} {
+  return generic.Foldl[interface{}, interface{}](stream.s, initVal, f)
+}
+
+// ForEach Evaluate the stream executing operation on every item. Return stream
+func (stream *Stream) ForEach(operation func(interface{})) *Stream {
+  stream.s.ForEach(operation)
+  return stream
+}
+
+// Find Return the first item satisfying predicate or nil if no item is found. The stream is only
+// evaluated up to the found item
+func (stream *Stream) Find(predicate func(interface{}) bool) interface{} {
+  if item, ok := stream.s.Find(predicate); ok {
+    return item
+  }
+  return nil
+}
+
+// Exist Return true if some item satisfies predicate. The stream is only evaluated up to that item
+func (stream *Stream) Exist(predicate func(interface{}) bool) bool {
+  return stream.s.Exist(predicate)
+}
+
+// All Return true if all the items satisfy predicate. The stream is only evaluated up to the first
+// item not satisfying it
+func (stream *Stream) All(predicate func(interface{}) bool) bool {
+  return stream.s.All(predicate)
+}
@@ -0,0 +1,123 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestStream_Pipeline(t *testing.T) {
+
+  calls := 0
+  stream := NewStream(createSet()).Map(func(i interface{}) interface{} {
+    calls++
+    return 2 * i.(int)
+  }).Filter(func(i interface{}) bool {
+    return i.(int)%3 == 0
+  }).Take(5)
+
+  assert.Equal(t, calls, 0) // nothing is evaluated before a terminal operation
+
+  l := stream.ToSlist()
+  assert.Equal(t, l.ToSlice(), []interface{}{0, 6, 12, 18, 24})
+  assert.Equal(t, calls, 13) // evaluation stops at the fifth accepted item
+}
+
+func TestStream_MapIfDrop(t *testing.T) {
+
+  l := NewStream(createSet()).MapIf(func(i interface{}) interface{} {
+    return -i.(int)
+  }, func(i interface{}) bool {
+    return i.(int) < 10
+  }).Drop(7).ToSlist()
+
+  assert.Equal(t, l.ToSlice(), []interface{}{-7, -8, -9})
+  assert.True(t, NewStream(createSet()).Drop(N + 1).IsEmpty())
+}
+
+func TestStream_TakeWhileDropWhile(t *testing.T) {
+
+  stream := NewStream(createSet()).DropWhile(func(i interface{}) bool {
+    return i.(int) < 20
+  }).TakeWhile(func(i interface{}) bool {
+    return i.(int) < 23
+  })
+  assert.Equal(t, stream.ToSlist().ToSlice(), []interface{}{20, 21, 22})
+}
+
+func TestStream_Terminals(t *testing.T) {
+
+  stream := NewStream(createSet())
+
+  assert.Equal(t, stream.Foldl(0, func(acu, item interface{}) interface{} {
+    return acu.(int) + item.(int)
+  }).(int), N*(N-1)/2)
+
+  i := 0
+  stream.ForEach(func(item interface{}) {
+    assert.Equal(t, item, i)
+    i++
+  })
+  assert.Equal(t, i, N)
+  assert.Equal(t, stream.Size(), N)
+
+  evaluated := 0
+  found := stream.Filter(func(item interface{}) bool {
+    evaluated++
+    return true
+  }).Find(func(item interface{}) bool {
+    return item.(int) == 5
+  })
+  assert.Equal(t, found, 5)
+  assert.Equal(t, evaluated, 6)
+
+  assert.Nil(t, stream.Find(func(item interface{}) bool { return item.(int) >= N }))
+  assert.True(t, stream.Exist(func(item interface{}) bool { return item.(int) == N-1 }))
+  assert.False(t, stream.All(func(item interface{}) bool { return item.(int) < N/2 }))
+}
+
+func TestStream_AsSequence(t *testing.T) {
+
+  stream := NewStream(Seq.New(1, 2, 3, 4)).Map(func(i interface{}) interface{} {
+    return 10 * i.(int)
+  })
+
+  var items []interface{}
+  for it := stream.CreateIterator().(SequentialIterator); it.HasCurr(); it.Next() {
+    items = append(items, it.GetCurr())
+  }
+  assert.Equal(t, items, []interface{}{10, 20, 30, 40})
+
+  it := stream.CreateIterator().(SequentialIterator)
+  it.Next()
+  it.ResetFirst()
+  assert.Equal(t, it.GetCurr(), 10)
+}
+
+func TestStream_Combinators(t *testing.T) {
+
+  evaluated := 0
+  naturals := NewStream(createSet()).Map(func(i interface{}) interface{} {
+    evaluated++
+    return i
+  })
+
+  var seq Sequence = naturals
+  assert.Equal(t, Take(seq, 2).ToSlice(), []interface{}{0, 1})
+  assert.Equal(t, evaluated, 2)
+
+  pairs := Zip(NewStream(Seq.New("a", "b")), Permutations(Seq.New(1, 2)))
+  assert.Equal(t, pairs.Size(), 2)
+  assert.Equal(t, pairs.First().(Pair).Item1, "a")
+  assert.Equal(t, *pairs.First().(Pair).Item2.(*Tuple).l, []interface{}{1, 2})
+
+  appended := NewStream(Seq.New(1, 2)).Filter(func(i interface{}) bool { return i.(int) > 1 })
+  appended.Append(3, 4)
+  assert.Equal(t, appended.ToSlist().ToSlice(), []interface{}{2, 3, 4})
+  assert.Equal(t, appended.Create(5, 6).(*Stream).ToSlist().ToSlice(), []interface{}{5, 6})
+
+  other := NewStream(Seq.New(7))
+  appended.Swap(other)
+  assert.Equal(t, appended.ToSlist().ToSlice(), []interface{}{7})
+  assert.Equal(t, other.Size(), 3)
+}
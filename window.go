@@ -0,0 +1,31 @@
+package FunctionalLib
+
+import (
+  "github.com/lrleon/FunctionalLib/generic"
+  Seq "github.com/lrleon/Slist"
+)
+
+// Chunk Return a list of *Tuple with the consecutive items of seq grouped by n. The last tuple is shorter if
+// the size of seq is not multiple of n
+func Chunk(seq Sequence, n int) *Seq.Slist {
+  return Chunks(seq, n).ToSlist()
+}
+
+// Chunks Return a lazy stream of *Tuple with the consecutive items of seq grouped by n
+func Chunks(seq Sequence, n int) *Stream {
+  return tupleStream(generic.Chunks(generic.Of[interface{}](seq), n))
+}
+
+// SlidingWindow Return a lazy stream of *Tuple with the windows of size consecutive items of seq. Each window
+// starts step items after the previous one and trailing windows with less than size items are not generated
+func SlidingWindow(seq Sequence, size, step int) *Stream {
+  return tupleStream(generic.SlidingWindow(generic.Of[interface{}](seq), size, step))
+}
+
+// Pairwise Return a lazy stream with the Pair of adjacent items of seq: (i1, i2), (i2, i3), ...
+func Pairwise(seq Sequence) *Stream {
+  return &Stream{s: generic.MapStream(generic.Pairwise(generic.Of[interface{}](seq)),
+    func(p Pair) interface{} {
+      return p
+    })}
+}
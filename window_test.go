@@ -0,0 +1,52 @@
+package FunctionalLib
+
+import (
+  Seq "github.com/lrleon/Slist"
+  "github.com/stretchr/testify/assert"
+  "testing"
+)
+
+func TestChunk(t *testing.T) {
+
+  chunks := Chunk(Seq.New(1, 2, 3, 4, 5, 6, 7), 3)
+  assert.Equal(t, tuplesToSlices(chunks), [][]interface{}{{1, 2, 3}, {4, 5, 6}, {7}})
+  assert.True(t, Chunk(Seq.New(), 3).IsEmpty())
+  assert.Equal(t, Chunk(createSet(), 10).Size(), N/10)
+  assert.Panics(t, func() { Chunk(Seq.New(1), 0) })
+
+  assert.Equal(t, tuplesToSlices(Chunks(NewTuple("a", "b", "c"), 2).Take(1).ToSlist()),
+    [][]interface{}{{"a", "b"}})
+}
+
+func TestSlidingWindow(t *testing.T) {
+
+  series := Seq.New(1, 2, 3, 4, 5, 6)
+  assert.Equal(t, tuplesToSlices(SlidingWindow(series, 3, 1).ToSlist()),
+    [][]interface{}{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5, 6}})
+  assert.Equal(t, tuplesToSlices(SlidingWindow(series, 2, 2).ToSlist()),
+    [][]interface{}{{1, 2}, {3, 4}, {5, 6}})
+  assert.Equal(t, tuplesToSlices(SlidingWindow(series, 2, 3).ToSlist()),
+    [][]interface{}{{1, 2}, {4, 5}})
+  assert.True(t, SlidingWindow(series, 7, 1).IsEmpty())
+  assert.Panics(t, func() { SlidingWindow(series, 2, 0) })
+
+  // moving average
+  averages := SlidingWindow(createSet(), 4, 1).Map(func(w interface{}) interface{} {
+    return Foldl(w.(*Tuple), 0, func(acu, i interface{}) interface{} {
+      return acu.(int) + i.(int)
+    }).(int) / 4
+  }).Take(3).ToSlist()
+  assert.Equal(t, averages.ToSlice(), []interface{}{1, 2, 3})
+}
+
+func TestPairwise(t *testing.T) {
+
+  pairs := Pairwise(Seq.New("a", "b", "c")).ToSlist()
+  assert.Equal(t, pairs.ToSlice(), []interface{}{Pair{Item1: "a", Item2: "b"}, Pair{Item1: "b", Item2: "c"}})
+  assert.True(t, Pairwise(Seq.New(1)).IsEmpty())
+
+  increasing := Pairwise(createSet()).All(func(p interface{}) bool {
+    return p.(Pair).Item1.(int) < p.(Pair).Item2.(int)
+  })
+  assert.True(t, increasing)
+}